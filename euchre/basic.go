@@ -0,0 +1,109 @@
+package euchre
+
+// Basic is the computer player the game has always had. It bids on the weighted score of its hand,
+// discards its lowest off suit card and plays each trick without counting cards.
+type Basic struct {
+	Bidding *BiddingConfig // nil for DefaultBidding
+}
+
+func (Basic) Name() string {
+	return "basic"
+}
+
+func (b Basic) bidding() BiddingConfig {
+	if b.Bidding == nil {
+		return DefaultBidding()
+	}
+	return *b.Bidding
+}
+
+// Bid orders up or names the suit when the hand scores well enough for where the seat sits. A stuck dealer always calls.
+func (b Basic) Bid(view *PlayerView) (Call, Suit) {
+	config := b.bidding()
+	seat := SeatFromDealer(view.Seat, view.Dealer)
+	if view.FirstBiddingRound {
+		return config.OrderUp(view.CardMap, seat, view.UpCard), view.UpCard.Suit
+	}
+	call, suit := config.NameTrump(view.CardMap, seat, view.UpCard.Suit)
+	if call == Pass && view.DealerStuck {
+		call = OrderUp
+	}
+	return call, suit
+}
+
+// DefendAlone takes on a loner alone only with a hand that can stop them without help
+func (b Basic) DefendAlone(view *PlayerView) bool {
+	config := b.bidding()
+	return config.score(view.CardMap, view.Trump) >= config.DefendAlone
+}
+
+// Discard is the card PlanDiscard picks: one that voids a suit, never an off suit ace or trump if it can help it
+func (Basic) Discard(view *PlayerView) *Card {
+	return PlanDiscard(view.Hand, view.Trump).Card
+}
+
+// Play leads or follows with the card most likely to win the trick for the team
+func (Basic) Play(view *PlayerView) *Card {
+	card := basicPlay(view)
+	return &card
+}
+
+// basicPlay is Basic's choice as a value, the other strategies use it to play hands out
+func basicPlay(view *PlayerView) Card {
+	trump := view.Trump
+	cardMap := &view.CardMap
+	currentTrick := view.CurrentTrick()
+	if len(currentTrick) == 0 {
+		//we lead
+		trumpCards := cardMap.CardsInSuit(trump)
+		if view.Partner() == view.Caller && len(trumpCards) > 0 {
+			return *cardMap.Sort(trump, true)[0]
+		}
+		if offsuit := cardMap.getStrongestOffsuit(trump); offsuit != nil {
+			return *offsuit
+		}
+		// Nothing but trump left
+		return getStrongest(cardMap.ToSlice(), trump)
+	}
+	leadSuit := currentTrick[0].EffectiveSuit(trump)
+	winningCard, winningSeat := getWinningCard(currentTrick, view.trickSeats(), trump, leadSuit)
+	winningTeam := view.Partner() == winningSeat
+
+	hand := cardMap.ToSlice()
+	playable := getPlayableCards(hand, leadSuit, trump)
+	hasLeadSuit := len(playable.inSuit) > 0
+
+	if !hasLeadSuit {
+		if !winningTeam {
+			if len(playable.trump) > 0 {
+				betterTrump := getLowestWinningTrump(playable.trump, winningCard, trump, leadSuit)
+				if betterTrump != nil {
+					return *betterTrump
+				}
+			}
+			if len(playable.other) == 0 {
+				return getLowest(playable.trump, trump)
+			}
+			return getLowest(playable.other, trump)
+		} else {
+			shortSuit := findShortSuit(*cardMap, trump)
+			if shortSuit != -1 {
+				return getCardInSuit(*cardMap, shortSuit, true)
+			}
+			if len(playable.other) == 0 {
+				return getLowest(playable.trump, trump)
+			}
+			return getLowest(playable.other, trump)
+		}
+	} else {
+		if !winningTeam || isWeak(winningCard) {
+			winning := getStrongerThan(playable.inSuit, winningCard, trump)
+			if len(winning) > 0 {
+				return getStrongest(winning, trump)
+			}
+			return getLowest(playable.inSuit, trump)
+		} else {
+			return getLowest(playable.inSuit, trump)
+		}
+	}
+}
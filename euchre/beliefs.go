@@ -0,0 +1,250 @@
+package euchre
+
+import "math/rand"
+
+// Beliefs are a seat's guess at where each card it hasn't seen is: the chance each other seat holds it,
+// or that it is in the kitty. They are worked out from the view's bids and plays, so they are up to date
+// after every bid and play.
+//
+// A seat that didn't follow suit is out of that suit. A seat that ordered up or named trump is likely
+// to hold trump, one that passed is likely short of the top trumps. The dealer holds the turned up card
+// after picking it up, unless the dealer is the one looking and threw it away.
+type Beliefs struct {
+	Seat     int
+	Unseen   []*Card
+	odds     [][5]float64 // by card in Unseen, then the seats and the kitty
+	capacity [5]float64   // how many of the unseen cards each seat and the kitty hold
+}
+
+// kittyPlace is where the kitty comes in the odds, after the four seats
+const kittyPlace = 4
+
+// How much a bid makes a seat more or less likely to hold a card of the suit it bid on
+const (
+	passedBower       = 0.5
+	passedHonor       = 0.8
+	passedSecondBower = 0.8
+	calledTrump       = 2.0
+	calledBower       = 3.0
+)
+
+// Beliefs is what the seat can make of the cards it hasn't seen
+func (view *PlayerView) Beliefs() *Beliefs {
+	beliefs := &Beliefs{Seat: view.Seat}
+	void := voids(view.Plays, view.Trump)
+	for _, card := range unseenCards(view) {
+		var odds [5]float64
+		if sameCard(card, view.TurnedUp) {
+			odds[view.turnedUpPlace()] = 1
+		} else {
+			for seat := range view.Players {
+				if seat == view.Seat {
+					continue
+				}
+				if suit := card.EffectiveSuit(view.Trump); validSuit(suit) && void[seat][suit] {
+					continue
+				}
+				odds[seat] = view.bidWeight(seat, card)
+			}
+			odds[kittyPlace] = 1
+		}
+		beliefs.Unseen = append(beliefs.Unseen, card)
+		beliefs.odds = append(beliefs.odds, odds)
+	}
+
+	kitty := float64(len(beliefs.Unseen))
+	for seat := range view.Players {
+		if seat != view.Seat {
+			beliefs.capacity[seat] = float64(view.HandSizes[seat])
+			kitty -= beliefs.capacity[seat]
+		}
+	}
+	beliefs.capacity[kittyPlace] = max(kitty, 0)
+	beliefs.balance()
+	return beliefs
+}
+
+// balance scales the odds until every card is somewhere and every place holds as many cards as it should
+func (b *Beliefs) balance() {
+	for round := 0; round < 50; round++ {
+		for place := range b.capacity {
+			total := 0.0
+			for _, odds := range b.odds {
+				total += odds[place]
+			}
+			if total > 0 {
+				for i := range b.odds {
+					b.odds[i][place] *= b.capacity[place] / total
+				}
+			}
+		}
+		for i := range b.odds {
+			total := 0.0
+			for _, odds := range b.odds[i] {
+				total += odds
+			}
+			if total > 0 {
+				for place := range b.odds[i] {
+					b.odds[i][place] /= total
+				}
+			}
+		}
+	}
+}
+
+// Probability is the chance the seat holds the card, zero for a card the seat has been seen to play
+// or couldn't have. A seat of -1 asks about the kitty.
+func (b *Beliefs) Probability(seat int, card *Card) float64 {
+	if seat < 0 {
+		seat = kittyPlace
+	}
+	for i, unseen := range b.Unseen {
+		if sameCard(unseen, card) {
+			return b.odds[i][seat]
+		}
+	}
+	return 0
+}
+
+// Void is true when the seat can't have any of the suit left
+func (b *Beliefs) Void(seat int, suit Suit, trump Suit) bool {
+	return b.Expected(seat, suit, trump) == 0
+}
+
+// Expected is how many cards of the suit the seat is expected to hold, the left bower counting as trump
+func (b *Beliefs) Expected(seat int, suit Suit, trump Suit) float64 {
+	expected := 0.0
+	for i, card := range b.Unseen {
+		if card.EffectiveSuit(trump) == suit {
+			expected += b.odds[i][seat]
+		}
+	}
+	return expected
+}
+
+// Sample deals the unseen cards out to the other seats, each card going where the beliefs say it's likely to be.
+// The seat keeps its own hand. The beliefs can rule out every deal, after a renege shows a seat out of a suit
+// it still holds, so after a few failed deals any place with room will do.
+func (b *Beliefs) Sample(view *PlayerView, rng *rand.Rand) [4][]*Card {
+	order := make([]int, len(b.Unseen))
+	for attempt := 0; ; attempt++ {
+		for i := range order {
+			order[i] = i
+		}
+		rng.Shuffle(len(order), func(i, j int) { order[i], order[j] = order[j], order[i] })
+		var hands [4][]*Card
+		hands[view.Seat] = view.Hand
+		room := b.capacity
+		dealt := true
+		for _, i := range order {
+			total := 0.0
+			for place, odds := range b.odds[i] {
+				if room[place] >= 1 {
+					total += b.weight(odds, attempt)
+				}
+			}
+			if total == 0 {
+				if attempt < sampleAttempts {
+					dealt = false
+					break
+				}
+				continue // nowhere has room, the card stays out of the hands
+			}
+			pick, chosen := rng.Float64()*total, -1
+			for place, odds := range b.odds[i] {
+				if room[place] < 1 || b.weight(odds, attempt) == 0 {
+					continue
+				}
+				chosen = place
+				if pick -= b.weight(odds, attempt); pick < 0 {
+					break
+				}
+			}
+			room[chosen]--
+			if chosen != kittyPlace {
+				hands[chosen] = append(hands[chosen], b.Unseen[i])
+			}
+		}
+		if dealt {
+			return hands
+		}
+	}
+}
+
+// sampleAttempts is how many deals follow the beliefs before the cards are dealt at random
+const sampleAttempts = 10
+
+// weight is the odds used for a sample, once the deals that follow the beliefs have failed every place counts the same
+func (b *Beliefs) weight(odds float64, attempt int) float64 {
+	if attempt < sampleAttempts {
+		return odds
+	}
+	return 1
+}
+
+// bidWeight is how the seat's bids change the chance it holds the card
+func (view *PlayerView) bidWeight(seat int, card *Card) float64 {
+	weight := 1.0
+	for _, bid := range view.Bids {
+		if bid.Seat != seat {
+			continue
+		}
+		switch {
+		case bid.Call == Pass && bid.FirstRound:
+			weight *= passedWeight(card, bid.Suit)
+		case bid.Call == Pass:
+			for suit := Spades; suit <= Hearts; suit++ {
+				if view.TurnedUp == nil || suit != view.TurnedUp.Suit {
+					if isBower(card, suit) {
+						weight *= passedSecondBower
+					}
+				}
+			}
+		case isBower(card, bid.Suit):
+			weight *= calledBower
+		case card.EffectiveSuit(bid.Suit) == bid.Suit:
+			weight *= calledTrump
+		}
+	}
+	return weight
+}
+
+func passedWeight(card *Card, trump Suit) float64 {
+	switch {
+	case isBower(card, trump):
+		return passedBower
+	case card.Suit == trump && (card.Rank == 1 || card.Rank == 13):
+		return passedHonor
+	}
+	return 1
+}
+
+func isBower(card *Card, trump Suit) bool {
+	return card.Rank == 11 && card.EffectiveSuit(trump) == trump
+}
+
+// turnedUpPlace is where the turned up card went: the dealer took it if anyone ordered it up,
+// otherwise it stayed on the kitty. The dealer knows better, a turned up card that isn't in their
+// hand was discarded or never picked up, so it is in the kitty either way.
+func (view *PlayerView) turnedUpPlace() int {
+	if view.Seat == view.Dealer {
+		return kittyPlace
+	}
+	if len(view.SittingOut) > view.Dealer && view.SittingOut[view.Dealer] {
+		return kittyPlace
+	}
+	for _, bid := range view.Bids {
+		if bid.FirstRound && bid.Call != Pass {
+			return view.Dealer
+		}
+	}
+	return kittyPlace
+}
+
+func sameCard(a, b *Card) bool {
+	return a != nil && b != nil && a.Rank == b.Rank && a.Suit == b.Suit
+}
+
+func validSuit(suit Suit) bool {
+	return suit >= Spades && suit <= Hearts
+}
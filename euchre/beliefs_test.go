@@ -0,0 +1,164 @@
+package euchre
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// tableView is seat 0 holding the cards, with the dealer in seat 3 having turned up the nine of spades
+func tableView(hand ...*Card) *PlayerView {
+	view := &PlayerView{
+		Seat:       0,
+		Dealer:     3,
+		Players:    []string{"A", "B", "C", "D"},
+		HandSizes:  []int{len(hand), 5, 5, 5},
+		SittingOut: []bool{false, false, false, false},
+		TurnedUp:   NewCard(9, Spades),
+		Trump:      Suit(-1),
+		Caller:     -1,
+		Defender:   -1,
+		Rules:      DefaultRules(),
+	}
+	for _, card := range hand {
+		view.CardMap.AddToHand(card)
+	}
+	view.Hand = view.CardMap.ToSlice()
+	return view
+}
+
+func assertBalanced(t *testing.T, view *PlayerView, beliefs *Beliefs) {
+	for _, card := range beliefs.Unseen {
+		total := beliefs.Probability(-1, card)
+		for seat := 1; seat < 4; seat++ {
+			total += beliefs.Probability(seat, card)
+		}
+		assert.InDelta(t, 1, total, 0.01, "Expected the %s to be somewhere", cardName(card))
+	}
+	for seat := 1; seat < 4; seat++ {
+		held := 0.0
+		for _, card := range beliefs.Unseen {
+			held += beliefs.Probability(seat, card)
+		}
+		assert.InDelta(t, float64(view.HandSizes[seat]), held, 0.05)
+	}
+}
+
+func TestBeliefsSpreadTheUnseenCardsEvenly(t *testing.T) {
+	view := tableView(NewCard(1, Hearts), NewCard(13, Hearts), NewCard(10, Clubs), NewCard(9, Clubs), NewCard(12, Diamonds))
+	beliefs := view.Beliefs()
+	assert.Len(t, beliefs.Unseen, 19)
+	assertBalanced(t, view, beliefs)
+
+	// Before anyone bids the three hands and the kitty's three face down cards are all alike
+	jack := NewCard(11, Spades)
+	assert.InDelta(t, 5.0/18, beliefs.Probability(1, jack), 0.01)
+	assert.Equal(t, 1.0, beliefs.Probability(-1, view.TurnedUp), "Expected the up card to be on the kitty")
+	assert.Zero(t, beliefs.Probability(1, NewCard(1, Hearts)), "Expected the seat's own cards to be left out")
+}
+
+func TestBeliefsLearnFromBids(t *testing.T) {
+	view := tableView(NewCard(1, Hearts), NewCard(13, Hearts), NewCard(10, Clubs), NewCard(9, Clubs), NewCard(12, Diamonds))
+	view.Bids = []BidRecord{
+		{Seat: 1, Call: Pass, Suit: Spades, FirstRound: true},
+		{Seat: 2, Call: OrderUp, Suit: Spades, FirstRound: true},
+	}
+	view.Trump, view.Caller = Spades, 2
+	beliefs := view.Beliefs()
+	assertBalanced(t, view, beliefs)
+
+	right := NewCard(11, Spades)
+	assert.Less(t, beliefs.Probability(1, right), beliefs.Probability(3, right), "Expected a pass to make the right bower less likely")
+	assert.Greater(t, beliefs.Probability(2, right), beliefs.Probability(3, right), "Expected the caller to be likely to have it")
+	assert.Equal(t, 1.0, beliefs.Probability(3, view.TurnedUp), "Expected the dealer to have picked the up card up")
+	assert.Zero(t, beliefs.Probability(-1, view.TurnedUp))
+}
+
+func TestDealerKnowsTheUpCardWasDiscarded(t *testing.T) {
+	// Seat 0 dealt, picked up the nine of spades and threw it away
+	view := tableView(NewCard(11, Spades), NewCard(1, Spades), NewCard(13, Hearts), NewCard(10, Clubs), NewCard(9, Clubs))
+	view.Dealer = 0
+	view.Bids = []BidRecord{{Seat: 1, Call: OrderUp, Suit: Spades, FirstRound: true}}
+	view.Trump, view.Caller = Spades, 1
+	beliefs := view.Beliefs()
+	assertBalanced(t, view, beliefs)
+	assert.Equal(t, 1.0, beliefs.Probability(-1, view.TurnedUp), "Expected the discard to be in the kitty")
+
+	rng := rand.New(rand.NewSource(1))
+	for sample := 0; sample < 20; sample++ {
+		hands := beliefs.Sample(view, rng)
+		for seat := 1; seat < 4; seat++ {
+			assert.False(t, ContainsCard(hands[seat], view.TurnedUp), "Expected no one to be dealt the discard")
+		}
+	}
+}
+
+func TestBeliefsLearnVoidsFromPlays(t *testing.T) {
+	view := tableView(NewCard(13, Hearts), NewCard(10, Clubs), NewCard(9, Clubs), NewCard(12, Diamonds))
+	view.Trump, view.Caller, view.Lead = Clubs, 0, 0
+	view.HandSizes = []int{4, 4, 4, 4}
+	view.Plays = []PlayRecord{
+		{Trick: 0, Seat: 0, Card: NewCard(1, Hearts)},
+		{Trick: 0, Seat: 1, Card: NewCard(9, Hearts)},
+		{Trick: 0, Seat: 2, Card: NewCard(13, Diamonds)},
+		{Trick: 0, Seat: 3, Card: NewCard(10, Hearts)},
+	}
+	beliefs := view.Beliefs()
+	assertBalanced(t, view, beliefs)
+	assert.True(t, beliefs.Void(2, Hearts, Clubs))
+	assert.False(t, beliefs.Void(1, Hearts, Clubs))
+	assert.Zero(t, beliefs.Probability(2, NewCard(12, Hearts)))
+	assert.Zero(t, beliefs.Probability(1, NewCard(9, Hearts)), "Expected played cards to be known")
+}
+
+func TestBeliefsFollowTheEngine(t *testing.T) {
+	engine := NewEngine(CreateSeededGame(CreateComputerPlayers(), DefaultRules(), 20))
+	engine.NewGame(false)
+	unseen := math.MaxInt
+	for engine.Phase != PhaseHandOver {
+		view := engine.View(0)
+		beliefs := view.Beliefs()
+		assert.LessOrEqual(t, len(beliefs.Unseen), unseen, "Expected every play to tell seat 0 something")
+		unseen = len(beliefs.Unseen)
+		for seat := 1; seat < 4; seat++ {
+			for _, card := range engine.Round.Players[seat].CardMap.ToSlice() {
+				assert.Greater(t, beliefs.Probability(seat, card), 0.0, "Expected the beliefs to allow the %s", cardName(card))
+			}
+		}
+		assert.True(t, step(t, engine))
+	}
+}
+
+func TestSampleSurvivesAVoidThatIsWrong(t *testing.T) {
+	// After a renege every other seat has shown out of hearts, but the five hearts left can't all be in the kitty
+	view := tableView(NewCard(10, Clubs), NewCard(9, Clubs), NewCard(12, Diamonds), NewCard(13, Diamonds))
+	view.Trump, view.Caller, view.Lead = Clubs, 0, 0
+	view.HandSizes = []int{4, 4, 4, 4}
+	view.Plays = []PlayRecord{
+		{Trick: 0, Seat: 0, Card: NewCard(1, Hearts)},
+		{Trick: 0, Seat: 1, Card: NewCard(9, Spades)},
+		{Trick: 0, Seat: 2, Card: NewCard(10, Spades)},
+		{Trick: 0, Seat: 3, Card: NewCard(12, Spades)},
+	}
+	view.TurnedUp = NewCard(9, Diamonds)
+	beliefs := view.Beliefs()
+
+	sampled := make(chan [4][]*Card)
+	go func() { sampled <- beliefs.Sample(view, rand.New(rand.NewSource(1))) }()
+	select {
+	case hands := <-sampled:
+		var dealt []*Card
+		for seat := 1; seat < 4; seat++ {
+			assert.Len(t, hands[seat], 4)
+			for _, card := range hands[seat] {
+				assert.False(t, ContainsCard(dealt, card), "Expected the %s to be dealt once", cardName(card))
+				dealt = append(dealt, card)
+			}
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Expected a deal even though the beliefs rule every deal out")
+	}
+}
@@ -0,0 +1,165 @@
+package euchre
+
+import (
+	"encoding/json"
+	"io"
+	"os"
+)
+
+// The bidding model knows where it sits. Seats are counted from the dealer's left: the first seat leads,
+// the third seat is the dealer's partner and the dealer bids last.
+const (
+	FirstSeat = iota
+	SecondSeat
+	ThirdSeat
+	DealerSeat
+)
+
+// BiddingConfig is how much a hand has to be worth, scored by its Weights, before the bidding model calls.
+// Every threshold is by seat from the dealer's left. A config saved to a file is a bidding profile,
+// the tuner writes one and the -bidding flag loads it.
+type BiddingConfig struct {
+	Weights     HandWeights `json:"weights"`
+	Order       [4]int      `json:"order"`       // to order up the up card in the first round
+	Name        [4]int      `json:"name"`        // to name a suit in the second round
+	Alone       int         `json:"alone"`       // to go alone instead
+	DefendAlone int         `json:"defendAlone"` // to defend alone against a loner
+	Next        int         `json:"next"`        // added to next, the suit the same colour as the one turned down, by the first and third seats
+	Cross       int         `json:"cross"`       // added to the other colour's suits by the second seat and the dealer
+	UpBower     int         `json:"upBower"`     // what a bower turned up is worth to the dealer's team
+	UpTrump     int         `json:"upTrump"`     // what any other trump turned up is worth to them
+}
+
+func DefaultBidding() BiddingConfig {
+	return BiddingConfig{
+		Weights:     DefaultWeights(),
+		Order:       [4]int{7, 7, 7, 7},
+		Name:        [4]int{7, 7, 7, 7},
+		Alone:       12,
+		DefendAlone: 10,
+		Next:        1,
+		Cross:       0,
+		UpBower:     3,
+		UpTrump:     2,
+	}
+}
+
+// SeatFromDealer is where the seat bids, FirstSeat to DealerSeat
+func SeatFromDealer(seat, dealer int) int {
+	return (seat - dealer + 3) % 4
+}
+
+// OrderUp is the first round bid. The up card counts for the hand when the dealer is on the seat's team and
+// against it otherwise, the dealer counts its hand as it would be after picking up and discarding.
+// The first seat passes a hand that is better in next, to name it in the second round.
+func (config BiddingConfig) OrderUp(hand CardMap, seat int, upCard *Card) Call {
+	trump := upCard.Suit
+	var score int
+	switch seat {
+	case DealerSeat:
+		hand.AddToHand(upCard)
+		if discard := PlanDiscard(hand.ToSlice(), trump).Card; discard != nil {
+			hand.RemoveFromHand(*discard)
+		}
+		score = config.score(hand, trump)
+	case ThirdSeat:
+		score = config.score(hand, trump) + config.upCardValue(upCard)
+	default:
+		score = config.score(hand, trump) - config.upCardValue(upCard)
+	}
+	call := config.call(score, config.Order[seat])
+	// The dealer sits out when the third seat goes alone, so the up card only helps an ordinary order up
+	if call == Alone && seat == ThirdSeat && score-config.upCardValue(upCard) < config.Alone {
+		call = OrderUp
+	}
+	if call == OrderUp && seat == FirstSeat {
+		if next := config.nameScore(hand, seat, trump, trump.GetWeakColor()); next > score && next >= config.Name[seat] {
+			return Pass
+		}
+	}
+	return call
+}
+
+// NameTrump is the second round bid, the best suit other than the one turned down and whether it's worth calling
+func (config BiddingConfig) NameTrump(hand CardMap, seat int, turnedDown Suit) (Call, Suit) {
+	best, bestScore := turnedDown, -1
+	for _, suit := range []Suit{Spades, Diamonds, Clubs, Hearts} {
+		if suit == turnedDown {
+			continue
+		}
+		if score := config.nameScore(hand, seat, turnedDown, suit); score > bestScore {
+			best, bestScore = suit, score
+		}
+	}
+	return config.call(bestScore, config.Name[seat]), best
+}
+
+// nameScore is the hand's worth with the suit as trump, with the seat's bonus for next or cross
+func (config BiddingConfig) nameScore(hand CardMap, seat int, turnedDown Suit, suit Suit) int {
+	score := config.score(hand, suit)
+	next := suit == turnedDown.GetWeakColor()
+	switch {
+	case next && (seat == FirstSeat || seat == ThirdSeat):
+		score += config.Next
+	case !next && (seat == SecondSeat || seat == DealerSeat):
+		score += config.Cross
+	}
+	return score
+}
+
+// score is the hand's worth with the suit as trump
+func (config BiddingConfig) score(hand CardMap, trump Suit) int {
+	return hand.WeightedScore(trump, config.Weights)
+}
+
+func (config BiddingConfig) upCardValue(upCard *Card) int {
+	if upCard.Rank == 11 {
+		return config.UpBower
+	}
+	return config.UpTrump
+}
+
+func (config BiddingConfig) call(score, threshold int) Call {
+	switch {
+	case score >= config.Alone:
+		return Alone
+	case score >= threshold:
+		return OrderUp
+	}
+	return Pass
+}
+
+func (config BiddingConfig) Write(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(config)
+}
+
+// SaveBiddingConfig writes the config to a file LoadBiddingConfig can read back
+func SaveBiddingConfig(path string, config BiddingConfig) error {
+	file, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if err := config.Write(file); err != nil {
+		file.Close()
+		return err
+	}
+	return file.Close()
+}
+
+func ReadBiddingConfig(r io.Reader) (BiddingConfig, error) {
+	config := DefaultBidding()
+	err := json.NewDecoder(r).Decode(&config)
+	return config, err
+}
+
+// LoadBiddingConfig reads a config file, anything the file leaves out keeps its default
+func LoadBiddingConfig(path string) (BiddingConfig, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return BiddingConfig{}, err
+	}
+	defer file.Close()
+	return ReadBiddingConfig(file)
+}
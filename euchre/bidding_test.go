@@ -0,0 +1,97 @@
+package euchre
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func handOf(cards ...*Card) CardMap {
+	var hand CardMap
+	for _, card := range cards {
+		hand.AddToHand(card)
+	}
+	return hand
+}
+
+func TestSeatFromDealer(t *testing.T) {
+	assert.Equal(t, FirstSeat, SeatFromDealer(3, 2))
+	assert.Equal(t, SecondSeat, SeatFromDealer(0, 2))
+	assert.Equal(t, ThirdSeat, SeatFromDealer(0, 1))
+	assert.Equal(t, DealerSeat, SeatFromDealer(2, 2))
+}
+
+func TestTheUpCardCountsForTheDealersTeam(t *testing.T) {
+	// Three middling hearts and an off ace
+	hand := handOf(NewCard(1, Hearts), NewCard(13, Hearts), NewCard(10, Hearts), NewCard(1, Clubs), NewCard(9, Spades))
+	bower := NewCard(11, Hearts)
+	config := DefaultBidding()
+	assert.Equal(t, OrderUp, config.OrderUp(hand, ThirdSeat, bower), "Expected to order the bower to partner")
+	assert.Equal(t, Pass, config.OrderUp(hand, SecondSeat, bower), "Expected not to hand the opponents a bower")
+	assert.Equal(t, Alone, config.OrderUp(hand, DealerSeat, bower), "Expected the dealer to count the bower and keep the ace of clubs")
+}
+
+func TestThirdSeatGoesAloneWithoutTheUpCard(t *testing.T) {
+	// Worth 9 in hearts, 12 with the bower partner would pick up, but partner sits out of a loner
+	hand := handOf(NewCard(11, Diamonds), NewCard(1, Hearts), NewCard(13, Hearts), NewCard(1, Spades), NewCard(9, Clubs))
+	config := DefaultBidding()
+	assert.Equal(t, 9, config.score(hand, Hearts))
+	assert.Equal(t, OrderUp, config.OrderUp(hand, ThirdSeat, NewCard(11, Hearts)))
+
+	config.Alone = 9
+	assert.Equal(t, Alone, config.OrderUp(hand, ThirdSeat, NewCard(11, Hearts)))
+}
+
+func TestDealerCountsTheHandAfterDiscarding(t *testing.T) {
+	// Three trumps with the up card are only worth 6, until the dealer throws the 9 of clubs away and is void
+	hand := handOf(NewCard(13, Spades), NewCard(12, Spades), NewCard(9, Clubs), NewCard(10, Diamonds), NewCard(10, Hearts))
+	config := DefaultBidding()
+	assert.Equal(t, OrderUp, config.OrderUp(hand, DealerSeat, NewCard(9, Spades)))
+	assert.Equal(t, Pass, config.OrderUp(hand, ThirdSeat, NewCard(9, Spades)))
+}
+
+func TestFirstSeatWaitsToCallNext(t *testing.T) {
+	// Good enough to order spades, better in clubs which is next if spades are turned down
+	hand := handOf(NewCard(11, Clubs), NewCard(11, Spades), NewCard(1, Clubs), NewCard(13, Spades), NewCard(1, Hearts))
+	config := DefaultBidding()
+	config.Order[FirstSeat] = 5
+	up := NewCard(9, Spades)
+	assert.Equal(t, Pass, config.OrderUp(hand, FirstSeat, up))
+	assert.Equal(t, OrderUp, config.OrderUp(hand, SecondSeat, up))
+
+	call, suit := config.NameTrump(hand, FirstSeat, Spades)
+	assert.Equal(t, OrderUp, call)
+	assert.Equal(t, Clubs, suit)
+}
+
+func TestNextAndCrossBreakTies(t *testing.T) {
+	// Just as good in clubs (next to spades) as in hearts (cross)
+	hand := handOf(NewCard(1, Clubs), NewCard(13, Clubs), NewCard(1, Hearts), NewCard(13, Hearts), NewCard(9, Diamonds))
+	config := DefaultBidding()
+	config.Next, config.Cross = 1, 1
+	_, suit := config.NameTrump(hand, FirstSeat, Spades)
+	assert.Equal(t, Clubs, suit)
+	_, suit = config.NameTrump(hand, DealerSeat, Spades)
+	assert.Equal(t, Hearts, suit)
+}
+
+func TestBiddingConfigFromJSON(t *testing.T) {
+	config, err := ReadBiddingConfig(strings.NewReader(`{"order": [9, 8, 6, 6], "alone": 14}`))
+	assert.NoError(t, err)
+	assert.Equal(t, [4]int{9, 8, 6, 6}, config.Order)
+	assert.Equal(t, 14, config.Alone)
+	assert.Equal(t, DefaultBidding().Name, config.Name, "Expected what the file leaves out to keep its default")
+}
+
+func TestBasicBidsWithItsConfig(t *testing.T) {
+	eager := DefaultBidding()
+	eager.Order = [4]int{}
+	eager.Name = [4]int{100, 100, 100, 100} // nothing to wait for in the second round
+	engine := NewEngine(CreateSeededGame(CreateComputerPlayers(), DefaultRules(), 5))
+	engine.NewGame(false)
+	view := engine.View(engine.ActiveSeat())
+	call, suit := Basic{Bidding: &eager}.Bid(view)
+	assert.NotEqual(t, Pass, call)
+	assert.Equal(t, view.UpCard.Suit, suit)
+}
@@ -1,4 +1,4 @@
-package main
+package euchre
 
 import (
 	"fmt"
@@ -20,7 +20,12 @@ const (
 	SuitColorRed
 )
 
+// NoTrump is called in place of a suit when the rules allow a hand without trump
+const NoTrump Suit = 4
+
 const (
+	// The joker of the 25 card deck sits in the otherwise unused zero rank
+	JokerRank = 0
 	Jack = 11
 	Queen = 12
 	King = 13
@@ -28,10 +33,9 @@ const (
 )
 
 type Card struct {
-	Rank int
-	Suit Suit
-	FaceUp bool
-	
+	Rank   int  `json:"rank"`
+	Suit   Suit `json:"suit"`
+	FaceUp bool `json:"faceUp,omitempty"`
 }
 
 
@@ -42,6 +46,15 @@ func NewCard(rank int, suit Suit) *Card {
 	return &Card{Rank: rank, Suit: suit}
 }
 
+// NewJoker is the joker (or benny) of the 25 card deck, the highest trump
+func NewJoker() *Card {
+	return &Card{Rank: JokerRank, Suit: Spades}
+}
+
+func (c *Card) IsJoker() bool {
+	return c.Rank == JokerRank
+}
+
 func (c *Card) Color() SuitColor {
 	if c.Suit == Clubs || c.Suit == Spades {
 		return SuitColorBlack
@@ -62,6 +75,8 @@ func (c *Card) SameColor(trump Suit) bool {
 
 func (c *Card) FriendlyRank() string {
     switch c.Rank {
+    case JokerRank:
+        return "Joker"
     case 1:
         return "Ace"
     case 11:
@@ -104,6 +119,8 @@ func (suit Suit) FriendlySuit() string {
 		return "Clubs"
 	case Hearts:
 		return "Hearts"
+	case NoTrump:
+		return "No Trump"
 	default:
 		return "Unknown"
 	}
@@ -118,7 +135,28 @@ func (color SuitColor) FriendlySuitColor() string {
 		return "Unknown"
 	}
 }
+// IsLeftBower is true for the jack of the same color as trump, which plays as trump
+func (c *Card) IsLeftBower(trump Suit) bool {
+	return c.Rank == Jack && c.SameColor(trump)
+}
+
+// EffectiveSuit is the suit the card follows in play, the left bower belongs to trump
+func (c *Card) EffectiveSuit(trump Suit) Suit {
+	if c.IsLeftBower(trump) || c.IsJoker() {
+		return trump
+	}
+	return c.Suit
+}
+
 func (c *Card) Beats(other *Card, trump Suit, lead Suit) bool {
+	// The joker beats everything
+	if c.IsJoker() {
+		return true
+	}
+	if other.IsJoker() {
+		return false
+	}
+
 	// Right bower check
 	if c.Rank == 11 && c.Suit == trump {
 		return true
@@ -132,7 +170,7 @@ func (c *Card) Beats(other *Card, trump Suit, lead Suit) bool {
 		if !(other.Rank == 11 && other.SameColor(trump) && other.Suit != trump) {
 			return true
 		}
-		return c.Rank > other.Rank
+		return c.highRank() > other.highRank()
 	}
 	if other.Rank == 11 && other.SameColor(trump) && other.Suit != trump {
 		return false
@@ -148,7 +186,7 @@ func (c *Card) Beats(other *Card, trump Suit, lead Suit) bool {
 
 	// Follow suit
 	if c.Suit == other.Suit {
-		return c.Rank > other.Rank
+		return c.highRank() > other.highRank()
 	}
 	if c.Suit == lead && other.Suit != lead {
 		return true
@@ -156,6 +194,14 @@ func (c *Card) Beats(other *Card, trump Suit, lead Suit) bool {
 	return false
 }
 
+// highRank orders the cards of a suit for taking tricks, the ace above the king
+func (c *Card) highRank() int {
+	if c.Rank == 1 {
+		return 14
+	}
+	return c.Rank
+}
+
 func (trump Suit)GetWeakColor() Suit {
 	// The weak color is the one matching trump, because the Jack becomes the left Bower.
 	switch trump {
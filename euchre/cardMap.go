@@ -1,4 +1,4 @@
-package main
+package euchre
 
 type CardMap struct {
 	Hand [4][14]bool // [Suit][Rank] - cards in player's hand
@@ -31,6 +31,22 @@ func (cm *CardMap) HasSeen(card *Card) bool {
 	return cm.Seen[card.Suit][card.Rank]
 }
 
+// SeenInSuit returns a seen card that follows the suit, counting the left bower as trump
+func (cm *CardMap) SeenInSuit(suit Suit, trump Suit) *Card {
+	for s := Spades; s <= Hearts; s++ {
+		for rank := 1; rank <= 13; rank++ {
+			if !cm.Seen[s][rank] {
+				continue
+			}
+			card := &Card{Suit: s, Rank: rank}
+			if card.EffectiveSuit(trump) == suit {
+				return card
+			}
+		}
+	}
+	return nil
+}
+
 func (cm *CardMap) CountSuits(trump Suit) map[Suit]int {
 	counts := make(map[Suit]int)
 	allSuits := []Suit{Spades, Diamonds, Clubs, Hearts}
@@ -47,6 +63,9 @@ func (cm *CardMap) CountSuits(trump Suit) map[Suit]int {
 			}
 		}
 	}
+	if cm.HasJoker() {
+		counts[trump]++
+	}
 
 	// Ensure all suits are represented
 	for _, suit := range allSuits {
@@ -58,6 +77,10 @@ func (cm *CardMap) CountSuits(trump Suit) map[Suit]int {
 	return counts
 }
 
+func (cm *CardMap) HasJoker() bool {
+	return cm.Hand[Spades][JokerRank]
+}
+
 func (cm *CardMap) hasLeftBower(trump Suit) bool {
 
 	cardSuit := trump.GetWeakColor()
@@ -78,7 +101,10 @@ func (cm CardMap) ToSlice() []*Card {
 
 func (cm CardMap) CardsInSuit(suit Suit) []*Card {
 	var cards []*Card
-	for rank := 0; rank < 14; rank++ {
+	if suit < Spades || suit > Hearts {
+		return cards
+	}
+	for rank := 1; rank < 14; rank++ {
 		if cm.Hand[suit][rank] {
 			cards = append(cards, &Card{Suit: suit, Rank: rank})
 		}
@@ -87,7 +113,10 @@ func (cm CardMap) CardsInSuit(suit Suit) []*Card {
 }
 func (cm CardMap) CountSuit(suit Suit) int {
 	count := 0
-	for rank := 0; rank < 14; rank++ {
+	if suit < Spades || suit > Hearts {
+		return count
+	}
+	for rank := 1; rank < 14; rank++ {
 		if cm.Hand[suit][rank] {
 			count++
 		}
@@ -95,12 +124,32 @@ func (cm CardMap) CountSuit(suit Suit) int {
 	return count
 }
 
+// HandWeights are what each feature of a hand is worth to WeightedScore
+type HandWeights struct {
+	Right         int `json:"right"`         // the right bower, and the joker
+	LeftWithTrump int `json:"leftWithTrump"` // the left bower with other trump beside it
+	Left          int `json:"left"`          // the left bower on its own
+	Trump         int `json:"trump"`         // every other trump
+	OffAce        int `json:"offAce"`        // an ace outside trump
+	Void          int `json:"void"`          // each suit the hand is out of
+}
+
+func DefaultWeights() HandWeights {
+	return HandWeights{Right: 3, LeftWithTrump: 3, Left: 2, Trump: 2, OffAce: 1, Void: 1}
+}
+
+// GetWScore is the hand's worth with the suit as trump, by the DefaultWeights
 func (cm *CardMap) GetWScore(trump Suit) int {
+	return cm.WeightedScore(trump, DefaultWeights())
+}
+
+func (cm *CardMap) WeightedScore(trump Suit, weights HandWeights) int {
 	// Right bower is worth 3 points
 	// Left bower is worth 3 points if there is other trump, otherwise it is worth 2
 	// All other trump is worth 2 points
 	// Offsuit Aces are worth 1 point each
 	// Being short-suited/void is worth 1 point for each suit.
+	// Those are the default weights.
 	// We also add the value of trump if ordering to partner, or subtract when ordering to opponent but that will be in the call.
 	score := 0
 	hasTrump := false
@@ -114,33 +163,39 @@ func (cm *CardMap) GetWScore(trump Suit) int {
 
 			// Right bower
 			if suit == trump && rank == 11 {
-				score += 3
+				score += weights.Right
 				hasTrump = true
 			} else if suit.SameColor(trump) && suit != trump && rank == 11 {
 				// Left bower (will count as trump)
 				hasLeft = true
 			} else if suit == trump {
-				score += 2
+				score += weights.Trump
 				hasTrump = true
 			} else if rank == 1 {
 				// Offsuit ace
-				score += 1
+				score += weights.OffAce
 			}
 		}
 	}
 
+	// The joker is the best trump there is
+	if cm.HasJoker() {
+		score += weights.Right
+		hasTrump = true
+	}
+
 	// Add bonus for left bower based on whether we have other trump
 	if hasLeft && hasTrump {
-		score += 3
+		score += weights.LeftWithTrump
 	} else if hasLeft {
-		score += 2
+		score += weights.Left
 	}
 
 	// Add points for void suits
 	suitCounts := cm.CountSuits(trump)
 	for _, count := range suitCounts {
 		if count == 0 {
-			score += 1
+			score += weights.Void
 		}
 	}
 
@@ -233,3 +288,16 @@ func (cm *CardMap) getStrongestOffsuit(trump Suit) *Card {
 
 	return nil
 }
+
+// HandBits packs the hand into one number, a bit for each card at suit*14+rank
+func (cm CardMap) HandBits() uint64 {
+	var bits uint64
+	for suit := 0; suit < 4; suit++ {
+		for rank := 0; rank < 14; rank++ {
+			if cm.Hand[suit][rank] {
+				bits |= 1 << (suit*14 + rank)
+			}
+		}
+	}
+	return bits
+}
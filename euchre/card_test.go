@@ -1,4 +1,4 @@
-package main
+package euchre
 
 import (
 	"testing"
@@ -55,3 +55,11 @@ func TestCardFaces(t *testing.T) {
 	card.TurnFaceDown()
 	assert.False(t,card.FaceUp, "Expected card that is turned face down to be face down")
 }
+
+func TestAcesAreHigh(t *testing.T) {
+	assert.True(t, NewCard(1, Hearts).Beats(NewCard(9, Hearts), Spades, Hearts), "Expected the ace to beat the 9 when following suit")
+	assert.False(t, NewCard(9, Hearts).Beats(NewCard(1, Hearts), Spades, Hearts))
+	assert.True(t, NewCard(1, Spades).Beats(NewCard(13, Spades), Spades, Hearts), "Expected the ace of trump to beat the king")
+	assert.False(t, NewCard(9, Spades).Beats(NewCard(1, Spades), Spades, Spades))
+	assert.True(t, NewCard(11, Clubs).Beats(NewCard(1, Spades), Spades, Spades), "Expected the left bower to beat the ace of trump")
+}
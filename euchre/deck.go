@@ -1,4 +1,4 @@
-package main
+package euchre
 
 import (
 	"math/rand"
@@ -41,12 +41,16 @@ func (d *Deck) Shuffle() {
 }
 
 func (d *Deck) ShuffleFromSeed(seed int64) {
-	for c := 0; c < len(d.Cards); c++ {
-		swap := rand.Intn(len(d.Cards))
-		if swap != c {
-			d.Cards[swap], d.Cards[c] = d.Cards[c], d.Cards[swap]
-		}
-	}
+	d.ShuffleWith(rand.New(rand.NewSource(seed)))
+}
+
+// ShuffleWith shuffles using the given source, so a seeded game deals the same cards every time
+func (d *Deck) ShuffleWith(rng *rand.Rand) {
+	d.ShuffleUsing(FisherYates{}, rng)
+}
+
+func (d *Deck) ShuffleUsing(shuffler Shuffler, rng *rand.Rand) {
+	shuffler.Shuffle(d.Cards, rng)
 }
 
 func (d *Deck) Deal() *Card {
@@ -1,4 +1,4 @@
-package main
+package euchre
 
 import (
 	"testing"
@@ -136,4 +136,13 @@ func TestBestTrumpScore(t *testing.T) {
 	suit, score := newMap.BestTrumpScore(trump);
 	assert.Equal(t,Hearts, suit)
 	assert.Equal(t,7,score)
-}
\ No newline at end of file
+}
+func TestShuffleFromSeedIsRepeatable(t *testing.T) {
+	first := NewStandardDeck()
+	second := NewStandardDeck()
+	first.ShuffleFromSeed(42)
+	second.ShuffleFromSeed(42)
+	assert.Equal(t, first.Cards, second.Cards)
+	second.ShuffleFromSeed(43)
+	assert.NotEqual(t, first.Cards, second.Cards)
+}
@@ -0,0 +1,38 @@
+package euchre
+
+// A defender may go alone against a loner when the rules allow it. The defenders are asked in turn,
+// left of the loner first, and the first to accept sits their partner out.
+
+// DefendAloneOpen is true while a defender can still choose to go alone against the loner
+func (round *Round) DefendAloneOpen() bool {
+	return round.rules().DefendAlone && round.Alone && round.Caller != nil && round.Defender == nil &&
+		round.DefenseOffered < 2
+}
+
+// DefendingSeat is the defender deciding whether to defend alone
+func (round *Round) DefendingSeat() int {
+	caller := round.seatOf(round.Caller)
+	if round.DefenseOffered == 0 {
+		return (caller + 1) % len(round.Players)
+	}
+	return (caller + 3) % len(round.Players)
+}
+
+// DefendAlone records the deciding defender's choice. A lone defender's partner sits out and the lead
+// moves on if they were due to lead.
+func (round *Round) DefendAlone(defend bool) {
+	if defend {
+		round.Defender = round.Players[round.DefendingSeat()]
+		round.seatPlayers()
+	}
+	round.DefenseOffered++
+}
+
+func (round *Round) seatOf(player *Player) int {
+	for seat, p := range round.Players {
+		if p == player {
+			return seat
+		}
+	}
+	return -1
+}
@@ -0,0 +1,99 @@
+package euchre
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func createDefendAloneRules() *Rules {
+	rules := DefaultRules()
+	rules.DefendAlone = true
+	rules.DefendPoints = 6
+	return &rules
+}
+
+func TestDefendAloneSitsBothPartnersOut(t *testing.T) {
+	players := CreatePlayers()
+	round := &Round{Players: players, Dealer: 3, Caller: players[2], Deck: DefaultRules().NewDeck(), Rules: createDefendAloneRules()}
+	round.BeginPlay(Alone, Hearts)
+
+	assert.True(t, round.DefendAloneOpen())
+	assert.Equal(t, 3, round.DefendingSeat(), "Expected the defender left of the loner to decide first")
+	round.DefendAlone(false)
+	assert.Equal(t, 1, round.DefendingSeat())
+	round.DefendAlone(true)
+	assert.False(t, round.DefendAloneOpen())
+
+	assert.True(t, round.SittingOut(0))
+	assert.True(t, round.SittingOut(3))
+	assert.False(t, players[3].IsPlaying)
+	assert.Equal(t, 2, round.PlayingCount())
+	assert.Equal(t, 1, round.Lead, "Expected the lead to skip the dealer sitting out")
+	assert.Equal(t, 2, round.NextPlayer(1))
+	assert.Equal(t, 1, round.NextPlayer(2))
+}
+
+func TestDefendAloneNotOfferedWithoutRule(t *testing.T) {
+	players := CreatePlayers()
+	round := &Round{Players: players, Dealer: 3, Caller: players[0], Deck: DefaultRules().NewDeck()}
+	round.BeginPlay(Alone, Hearts)
+	assert.False(t, round.DefendAloneOpen())
+
+	round.Rules = createDefendAloneRules()
+	round.BeginPlay(OrderUp, Hearts)
+	assert.False(t, round.DefendAloneOpen(), "Expected defend alone only against a loner")
+}
+
+func TestLoneDefenderEuchreBonus(t *testing.T) {
+	round := createScoredRound(0, true, [4]int{2, 3, 0, 0})
+	round.Rules = createDefendAloneRules()
+	round.Defender = round.Players[1]
+	result := round.ScoreHand()
+	assert.True(t, result.Euchred)
+	assert.True(t, result.DefendedAlone)
+	assert.Equal(t, 6, result.Points)
+	assert.Equal(t, 6, round.Players[1].Team.Score)
+	assert.Contains(t, result.Describe(), "lone defender")
+}
+
+func TestLoneDefenderLosesToLonerMarch(t *testing.T) {
+	round := createScoredRound(0, true, [4]int{5, 0, 0, 0})
+	round.Rules = createDefendAloneRules()
+	round.Defender = round.Players[3]
+	result := round.ScoreHand()
+	assert.Equal(t, 4, result.Points)
+	assert.Equal(t, 4, round.Players[0].Team.Score)
+}
+
+func TestEngineDefendAloneTwoCardTricks(t *testing.T) {
+	players := CreateComputerPlayers()
+	game := CreateEuchreGameWithRules(players, *createDefendAloneRules())
+	engine := NewEngine(game)
+	engine.NewGame(false)
+	round := engine.Round
+
+	caller := round.ActivePlayer
+	assert.NoError(t, engine.Bid(caller, Alone, round.UpCard().Suit))
+	assert.Equal(t, PhaseDefendAlone, engine.Phase)
+	defender := (caller + 1) % 4
+	assert.Equal(t, defender, engine.ActiveSeat())
+	assert.ErrorIs(t, engine.DefendAlone((caller+3)%4, true), ErrNotYourTurn)
+	assert.NoError(t, engine.DefendAlone(defender, true))
+	assert.NotEqual(t, PhaseDefendAlone, engine.Phase)
+
+	tricks := 0
+	engine.Subscribe(func(event Event) {
+		switch event.Type {
+		case EventPlay:
+			assert.Contains(t, []int{caller, defender}, event.Seat)
+		case EventTrickWon:
+			tricks++
+			assert.Nil(t, event.Trick[(caller+2)%4])
+			assert.Nil(t, event.Trick[(defender+2)%4])
+		}
+	})
+	assert.NoError(t, engine.RunBots())
+	assert.Equal(t, tricksPerHand, tricks)
+	assert.True(t, round.Result.DefendedAlone)
+}
@@ -0,0 +1,79 @@
+package euchre
+
+import "fmt"
+
+// A DiscardPlan is the card the dealer should throw away after picking up, and why
+type DiscardPlan struct {
+	Card   *Card  `json:"card"`
+	Reason string `json:"reason"`
+}
+
+// PlanDiscard picks the dealer's discard by the suit each card follows, so the left bower counts as trump.
+// It throws away a card that leaves the hand void in a suit when it can, otherwise the lowest card outside trump.
+// Aces outside trump are kept unless they're all that's left, and trump only goes when the hand is all trump.
+func PlanDiscard(hand []*Card, trump Suit) DiscardPlan {
+	suits := map[Suit]int{}
+	for _, card := range hand {
+		suits[card.EffectiveSuit(trump)]++
+	}
+
+	var discard, ace *Card
+	for _, card := range hand {
+		suit := card.EffectiveSuit(trump)
+		switch {
+		case suit == trump:
+			continue
+		case card.Rank == 1:
+			if ace == nil {
+				ace = card
+			}
+			continue
+		}
+		if discard == nil {
+			discard = card
+			continue
+		}
+		voids, discardVoids := suits[suit] == 1, suits[discard.Suit] == 1
+		if voids != discardVoids {
+			if voids {
+				discard = card
+			}
+			continue
+		}
+		if card.Rank < discard.Rank || card.Rank == discard.Rank && suits[suit] < suits[discard.Suit] {
+			discard = card
+		}
+	}
+
+	switch {
+	case discard != nil && suits[discard.Suit] == 1:
+		return DiscardPlan{discard, fmt.Sprintf("Throwing the %s leaves no %s", cardName(discard), discard.Suit.FriendlySuit())}
+	case discard != nil:
+		return DiscardPlan{discard, fmt.Sprintf("The %s is the lowest card outside trump", cardName(discard))}
+	case ace != nil:
+		return DiscardPlan{ace, fmt.Sprintf("Only aces are left outside trump, throwing the %s", cardName(ace))}
+	}
+
+	for _, card := range hand {
+		if discard == nil || trumpStrength(card, trump) < trumpStrength(discard, trump) {
+			discard = card
+		}
+	}
+	if discard == nil {
+		return DiscardPlan{}
+	}
+	return DiscardPlan{discard, fmt.Sprintf("Every card is trump, the %s is the lowest", cardName(discard))}
+}
+
+// trumpStrength orders trump from the 9 up to the right bower, with the joker above it
+func trumpStrength(card *Card, trump Suit) int {
+	switch {
+	case card.IsJoker():
+		return 17
+	case card.Rank == Jack && card.Suit == trump:
+		return 16
+	case card.IsLeftBower(trump):
+		return 15
+	}
+	return card.highRank()
+}
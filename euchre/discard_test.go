@@ -0,0 +1,57 @@
+package euchre
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiscardKeepsOffSuitAces(t *testing.T) {
+	hand := []*Card{NewCard(1, Clubs), NewCard(9, Spades), NewCard(10, Spades), NewCard(12, Hearts), NewCard(13, Hearts), NewCard(1, Hearts)}
+	plan := PlanDiscard(hand, Hearts)
+	assert.Equal(t, NewCard(9, Spades), plan.Card)
+	assert.Contains(t, plan.Reason, "lowest")
+}
+
+func TestDiscardMakesAVoid(t *testing.T) {
+	// The king of diamonds is the only diamond, the 9 of clubs has the 10 beside it
+	hand := []*Card{NewCard(13, Diamonds), NewCard(9, Clubs), NewCard(10, Clubs), NewCard(11, Spades), NewCard(1, Spades), NewCard(12, Spades)}
+	plan := PlanDiscard(hand, Spades)
+	assert.Equal(t, NewCard(13, Diamonds), plan.Card)
+	assert.Equal(t, "Throwing the King of Diamonds leaves no Diamonds", plan.Reason)
+}
+
+func TestDiscardTreatsTheLeftBowerAsTrump(t *testing.T) {
+	// The jack of diamonds would be the lowest heart or the lowest card by rank, but it is the left bower
+	hand := []*Card{NewCard(11, Diamonds), NewCard(12, Clubs), NewCard(1, Hearts), NewCard(13, Hearts), NewCard(10, Hearts), NewCard(9, Hearts)}
+	plan := PlanDiscard(hand, Hearts)
+	assert.Equal(t, NewCard(12, Clubs), plan.Card)
+}
+
+func TestDiscardOnlyThrowsTrumpWhenItMust(t *testing.T) {
+	hand := []*Card{NewCard(11, Clubs), NewCard(11, Spades), NewCard(1, Spades), NewCard(9, Spades), NewCard(10, Spades), NewCard(13, Spades)}
+	plan := PlanDiscard(hand, Spades)
+	assert.Equal(t, NewCard(9, Spades), plan.Card)
+	assert.Contains(t, plan.Reason, "Every card is trump")
+
+	hand[3] = NewCard(1, Hearts)
+	assert.Equal(t, NewCard(1, Hearts), PlanDiscard(hand, Spades).Card, "Expected an ace to go before trump")
+}
+
+func TestDealerViewSuggestsTheDiscard(t *testing.T) {
+	engine := NewEngine(CreateSeededGame(CreatePlayers(), DefaultRules(), 4))
+	engine.NewGame(false)
+	round := engine.Round
+	assert.Nil(t, engine.View(round.Dealer).DiscardPlan)
+
+	assert.NoError(t, engine.Bid(round.ActivePlayer, OrderUp, round.UpCard().Suit))
+	dealer := engine.View(round.Dealer)
+	if assert.NotNil(t, dealer.DiscardPlan) {
+		assert.True(t, dealer.CardMap.HasInHand(dealer.DiscardPlan.Card))
+		assert.NotEmpty(t, dealer.DiscardPlan.Reason)
+	}
+	assert.Nil(t, engine.View((round.Dealer+1)%4).DiscardPlan, "Expected only the dealer to see the suggestion")
+
+	assert.NoError(t, engine.Discard(round.Dealer, dealer.DiscardPlan.Card))
+	assert.Nil(t, engine.View(round.Dealer).DiscardPlan)
+}
@@ -0,0 +1,447 @@
+// Package euchre is the game without a display: the rules, the engine, the computer strategies and the
+// headless commands. The window in package main is one view over it, the terminal and the server are others.
+package euchre
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+)
+
+// The engine owns the flow of a game: deal, bidding, the dealer's discard, five tricks, scoring and the next deal.
+// It has no knowledge of Fyne so it can be driven from tests, a terminal or a server.
+
+type Phase int
+
+const (
+	PhaseDeal Phase = iota
+	PhaseBidding
+	PhaseDefendAlone
+	PhaseDiscard
+	PhasePlay
+	PhaseHandOver
+	PhaseGameOver
+)
+
+func (phase Phase) FriendlyPhase() string {
+	switch phase {
+	case PhaseDeal:
+		return "Deal"
+	case PhaseBidding:
+		return "Bidding"
+	case PhaseDefendAlone:
+		return "Defend alone"
+	case PhaseDiscard:
+		return "Discard"
+	case PhasePlay:
+		return "Play"
+	case PhaseHandOver:
+		return "Hand over"
+	case PhaseGameOver:
+		return "Game over"
+	default:
+		return "Unknown"
+	}
+}
+
+type EventType int
+
+const (
+	EventDeal EventType = iota
+	EventBid
+	EventThrowIn // everyone passed twice
+	EventDiscard
+	EventPlay
+	EventTrickWon
+	EventHandScored
+	EventGameOver
+	EventRenege
+	EventFarmersHand
+	EventDefendAlone // Call is Alone when the defender takes on the loner alone
+)
+
+// Event describes something that just happened in the engine.
+// Only the fields relevant to the Type are set.
+type Event struct {
+	Type   EventType
+	Seat   int
+	Call   Call
+	Suit   Suit
+	Card   *Card
+	Trick  [4]*Card // the completed trick, by seat
+	Result *HandResult
+}
+
+var (
+	ErrWrongPhase     = errors.New("that action isn't allowed right now")
+	ErrNotYourTurn    = errors.New("it isn't that seat's turn")
+	ErrCardNotInHand  = errors.New("that card isn't in the player's hand")
+	ErrSuitTurnedDown = errors.New("the turned down suit can't be called")
+	ErrNotDealer      = errors.New("only the dealer discards")
+	ErrInvalidSuit    = errors.New("that suit can't be called")
+	ErrNoFarmersHand  = errors.New("that isn't a farmer's hand")
+	ErrDealerStuck    = errors.New("the dealer is stuck and has to call")
+)
+
+// IllegalPlayError is returned when a player fails to follow the suit that was led
+type IllegalPlayError struct {
+	Seat     int
+	Card     *Card
+	LeadSuit Suit
+}
+
+func (err *IllegalPlayError) Error() string {
+	return fmt.Sprintf("seat %d must follow %s and can't play the %s of %s",
+		err.Seat, err.LeadSuit.FriendlySuit(), err.Card.FriendlyRank(), err.Card.Suit.FriendlySuit())
+}
+
+type Engine struct {
+	Game      *Game
+	Round     *Round
+	Phase     Phase
+	Trick     [4]*Card // cards in the current trick, by seat
+	LastTrick [4]*Card
+	Recording *Recording // every action taken, so the game can be replayed
+	listeners []func(Event)
+}
+
+func NewEngine(game *Game) *Engine {
+	return &Engine{Game: game, Phase: PhaseDeal, Recording: NewRecording(game)}
+}
+
+// Subscribe registers a listener that is called after every event
+func (e *Engine) Subscribe(listener func(Event)) {
+	e.listeners = append(e.listeners, listener)
+}
+
+func (e *Engine) emit(event Event) {
+	for _, listener := range e.listeners {
+		listener(event)
+	}
+}
+
+// NewGame resets the scores and deals the first hand
+func (e *Engine) NewGame(changeTeams bool) {
+	e.Recording.Add(Action{Type: ActionNewGame, ChangeTeams: changeTeams})
+	e.Game.NewGame(changeTeams)
+	e.startHand()
+}
+
+// NextHand deals the next hand once the previous one has been scored
+func (e *Engine) NextHand() error {
+	if e.Phase != PhaseHandOver {
+		return ErrWrongPhase
+	}
+	if e.Game.SomeoneWon() {
+		e.endGame()
+		return nil
+	}
+	e.Recording.Add(Action{Type: ActionNextHand})
+	e.Game.NewRound()
+	e.startHand()
+	return nil
+}
+
+func (e *Engine) startHand() {
+	e.Round = e.Game.Rounds[len(e.Game.Rounds)-1]
+	e.Trick = [4]*Card{}
+	e.LastTrick = [4]*Card{}
+	e.Phase = PhaseBidding
+	e.Recording.Add(dealAction(e.Round))
+	e.emit(Event{Type: EventDeal, Seat: e.Round.Dealer})
+
+	for seat, player := range e.Round.Players {
+		if player.ComputerPlayer && e.Round.HasFarmersHand(seat) {
+			e.FarmersExchange(seat, FarmersDiscards(player.CardMap.ToSlice()))
+		}
+	}
+}
+
+// FarmersExchange swaps three cards of a farmer's hand for the kitty before anyone has bid
+func (e *Engine) FarmersExchange(seat int, cards []*Card) error {
+	if e.Phase != PhaseBidding || !e.FirstBiddingRound() || e.Round.ActivePlayer != (e.Round.Dealer+1)%len(e.Round.Players) {
+		return ErrWrongPhase
+	}
+	if !e.Round.HasFarmersHand(seat) || len(cards) != 3 {
+		return ErrNoFarmersHand
+	}
+	for _, card := range cards {
+		if !e.Round.Players[seat].CardMap.HasInHand(card) {
+			return ErrCardNotInHand
+		}
+	}
+	e.Recording.Add(Action{Type: ActionFarmersHand, Seat: seat, Cards: cards})
+	e.Round.FarmersExchange(seat, cards)
+	e.emit(Event{Type: EventFarmersHand, Seat: seat})
+	return nil
+}
+
+// FarmersDiscards picks the three lowest cards of a farmer's hand
+func FarmersDiscards(hand []*Card) []*Card {
+	sorted := append([]*Card{}, hand...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Rank < sorted[j].Rank })
+	return sorted[:3]
+}
+
+// ActiveSeat is the seat the engine is waiting on
+func (e *Engine) ActiveSeat() int {
+	switch e.Phase {
+	case PhaseDefendAlone:
+		return e.Round.DefendingSeat()
+	case PhaseDiscard:
+		return e.Round.Dealer
+	}
+	return e.Round.ActivePlayer
+}
+
+// WaitingOnPlayer is true when the next action belongs to a seat, rather than moving on to the next hand
+func (e *Engine) WaitingOnPlayer() bool {
+	switch e.Phase {
+	case PhaseBidding, PhaseDefendAlone, PhaseDiscard, PhasePlay:
+		return true
+	default:
+		return false
+	}
+}
+
+// WaitingOnHuman reports whether the next action has to come from outside the engine
+func (e *Engine) WaitingOnHuman() bool {
+	return e.WaitingOnPlayer() && !e.Game.Players[e.ActiveSeat()].ComputerPlayer
+}
+
+// FirstBiddingRound is true while the up card can still be ordered
+func (e *Engine) FirstBiddingRound() bool {
+	upCard := e.Round.UpCard()
+	return upCard != nil && upCard.FaceUp
+}
+
+// CurrentTrick returns the cards played to the current trick in the order they were played
+func (e *Engine) CurrentTrick() []*Card {
+	var trick []*Card
+	players := len(e.Round.Players)
+	for i := 0; i < players; i++ {
+		if card := e.Trick[(e.Round.Lead+i)%players]; card != nil {
+			trick = append(trick, card)
+		}
+	}
+	return trick
+}
+
+// LegalPlays lists the cards the seat could play to the current trick
+func (e *Engine) LegalPlays(seat int) []*Card {
+	return LegalPlays(e.Round.Players[seat].CardMap.ToSlice(), e.CurrentTrick(), e.Round.Trump)
+}
+
+func (e *Engine) Bid(seat int, call Call, suit Suit) error {
+	if e.Phase != PhaseBidding {
+		return ErrWrongPhase
+	}
+	if seat != e.Round.ActivePlayer {
+		return ErrNotYourTurn
+	}
+	if call == Pass && e.Round.DealerIsStuck() {
+		return ErrDealerStuck
+	}
+	if call != Pass && (suit < Spades || suit > NoTrump) {
+		return ErrInvalidSuit
+	}
+	if call != Pass && !e.FirstBiddingRound() && suit == NoTrump && !e.Round.rules().NoTrump {
+		return ErrInvalidSuit
+	}
+	if call != Pass && !e.FirstBiddingRound() {
+		if upCard := e.Round.UpCard(); upCard != nil && upCard.Suit == suit {
+			return ErrSuitTurnedDown
+		}
+	}
+	if e.FirstBiddingRound() {
+		suit = e.Round.UpCard().Suit
+	}
+
+	e.Recording.Add(Action{Type: ActionBid, Seat: seat, Call: call, Suit: suit})
+	e.Round.Bid(call, suit)
+	e.emit(Event{Type: EventBid, Seat: seat, Call: call, Suit: suit})
+
+	switch {
+	case e.Round.SelectingTrump:
+		// Still bidding
+	case e.Round.PassedOut:
+		// The passed out hand stays in the game's rounds and the deal moves to the left
+		e.emit(Event{Type: EventThrowIn, Seat: e.Round.Dealer})
+		e.Game.NewRound()
+		e.startHand()
+	default:
+		e.startPlay()
+	}
+	return nil
+}
+
+// DefendAlone is a defender's answer to a loner, defending alone sits their partner out
+func (e *Engine) DefendAlone(seat int, defend bool) error {
+	if e.Phase != PhaseDefendAlone {
+		return ErrWrongPhase
+	}
+	if seat != e.Round.DefendingSeat() {
+		return ErrNotYourTurn
+	}
+	e.Recording.Add(Action{Type: ActionDefendAlone, Seat: seat, Defend: defend})
+	e.Round.DefendAlone(defend)
+	call := Pass
+	if defend {
+		call = Alone
+	}
+	e.emit(Event{Type: EventDefendAlone, Seat: seat, Call: call, Suit: e.Round.Trump})
+	e.startPlay()
+	return nil
+}
+
+// startPlay moves on from the bidding to whatever comes before the first lead
+func (e *Engine) startPlay() {
+	switch {
+	case e.Round.DefendAloneOpen():
+		e.Phase = PhaseDefendAlone
+	case e.Round.DealerMustDiscard() && !e.Round.SittingOut(e.Round.Dealer):
+		e.Phase = PhaseDiscard
+	default:
+		e.Phase = PhasePlay
+	}
+}
+
+func (e *Engine) Discard(seat int, card *Card) error {
+	if e.Phase != PhaseDiscard {
+		return ErrWrongPhase
+	}
+	if seat != e.Round.Dealer {
+		return ErrNotDealer
+	}
+	if !e.Round.Players[seat].CardMap.HasInHand(card) {
+		return ErrCardNotInHand
+	}
+	e.Recording.Add(Action{Type: ActionDiscard, Seat: seat, Card: card})
+	e.Round.Discard(card)
+	e.emit(Event{Type: EventDiscard, Seat: seat, Card: card})
+	e.Phase = PhasePlay
+	return nil
+}
+
+func (e *Engine) Play(seat int, card *Card) error {
+	if e.Phase != PhasePlay {
+		return ErrWrongPhase
+	}
+	if seat != e.Round.ActivePlayer {
+		return ErrNotYourTurn
+	}
+	player := e.Round.Players[seat]
+	if !player.CardMap.HasInHand(card) {
+		return ErrCardNotInHand
+	}
+	trick := e.CurrentTrick()
+	if !e.Round.rules().AllowReneges && !IsLegalPlay(card, player.CardMap.ToSlice(), trick, e.Round.Trump) {
+		return &IllegalPlayError{Seat: seat, Card: card, LeadSuit: trick[0].EffectiveSuit(e.Round.Trump)}
+	}
+
+	e.Recording.Add(Action{Type: ActionPlay, Seat: seat, Card: card})
+	played := player.PlayCard(card)
+	e.Round.RecordPlay(seat, played)
+	for _, p := range e.Round.Players {
+		p.CardMap.MarkSeen(played)
+	}
+	e.Trick[seat] = played
+	e.emit(Event{Type: EventPlay, Seat: seat, Card: played})
+
+	if !e.trickComplete() {
+		e.Round.ActivePlayer = e.Round.NextPlayer(seat)
+		return nil
+	}
+
+	winner := e.Round.ResolveTrick(e.Trick[:])
+	e.LastTrick = e.Trick
+	e.Trick = [4]*Card{}
+	e.emit(Event{Type: EventTrickWon, Seat: winner, Trick: e.LastTrick})
+
+	if e.Round.HandComplete() {
+		e.finishHand()
+	}
+	return nil
+}
+
+func (e *Engine) trickComplete() bool {
+	for seat := range e.Round.Players {
+		if !e.Round.SittingOut(seat) && e.Trick[seat] == nil {
+			return false
+		}
+	}
+	return true
+}
+
+func (e *Engine) finishHand() {
+	e.emit(Event{Type: EventHandScored, Result: e.Round.Result})
+	if e.Game.SomeoneWon() && !e.Round.rules().AllowReneges {
+		e.endGame()
+		return
+	}
+	// With reneges allowed the hand stays open so one can be called before the game is decided
+	e.Phase = PhaseHandOver
+}
+
+func (e *Engine) endGame() {
+	e.Game.RecordResults()
+	e.Phase = PhaseGameOver
+	e.emit(Event{Type: EventGameOver})
+}
+
+// CallRenege lets a seat call a renege on the other team after the hand
+func (e *Engine) CallRenege(seat int) (*Renege, error) {
+	if e.Phase != PhaseHandOver || !e.Round.rules().AllowReneges {
+		return nil, ErrWrongPhase
+	}
+	renege, err := e.Round.CallRenege(e.Round.Players[seat])
+	if err != nil {
+		return nil, err
+	}
+	e.Recording.Add(Action{Type: ActionCallRenege, Seat: seat})
+	e.emit(Event{Type: EventRenege, Seat: renege.Seat, Card: renege.Card, Result: e.Round.Result})
+	return renege, nil
+}
+
+// Step makes the next decision for a computer player. It returns false when the engine is waiting on a human
+// or the hand is over, and the error when the engine turned down the strategy's decision.
+func (e *Engine) Step() (bool, error) {
+	if !e.WaitingOnPlayer() {
+		return false, nil
+	}
+	seat := e.ActiveSeat()
+	player := e.Round.Players[seat]
+	if !player.ComputerPlayer {
+		return false, nil
+	}
+
+	// The seat's strategy decides from its view, it can't see the other hands
+	strategy := player.strategy()
+	view := e.View(seat)
+	var err error
+	switch e.Phase {
+	case PhaseBidding:
+		call, suit := strategy.Bid(view)
+		err = e.Bid(seat, call, suit)
+	case PhaseDefendAlone:
+		err = e.DefendAlone(seat, defendAlone(strategy, view))
+	case PhaseDiscard:
+		err = e.Discard(seat, strategy.Discard(view))
+	case PhasePlay:
+		err = e.Play(seat, strategy.Play(view))
+	}
+	if err != nil {
+		return false, fmt.Errorf("%s's %s strategy: %w", player.Name, strategy.Name(), err)
+	}
+	return true, nil
+}
+
+// RunBots lets the computer players act until a human has to decide or the hand is over
+func (e *Engine) RunBots() error {
+	for {
+		moved, err := e.Step()
+		if !moved {
+			return err
+		}
+	}
+}
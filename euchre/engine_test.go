@@ -0,0 +1,284 @@
+package euchre
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func CreateComputerPlayers() []*Player {
+	players := CreatePlayers()
+	for _, p := range players {
+		p.ComputerPlayer = true
+	}
+	return players
+}
+
+// step is engine.Step for a test, failing it when the engine turns down a computer player's decision
+func step(t *testing.T, engine *Engine) bool {
+	moved, err := engine.Step()
+	assert.NoError(t, err)
+	return moved
+}
+
+func playToEnd(t *testing.T, engine *Engine) {
+	for hands := 0; engine.Phase != PhaseGameOver; hands++ {
+		if hands > 500 {
+			t.Fatal("Expected the game to finish")
+		}
+		assert.NoError(t, engine.RunBots())
+		if engine.Phase == PhaseHandOver {
+			assert.NoError(t, engine.NextHand())
+		}
+	}
+}
+
+func TestEnginePlaysAFullGameHeadless(t *testing.T) {
+	game := CreateEuchreGame(CreateComputerPlayers())
+	engine := NewEngine(game)
+	tricks := 0
+	hands := 0
+	engine.Subscribe(func(event Event) {
+		switch event.Type {
+		case EventTrickWon:
+			tricks++
+		case EventHandScored:
+			hands++
+			assert.NotNil(t, event.Result)
+		}
+	})
+	engine.NewGame(false)
+	playToEnd(t, engine)
+
+	assert.Equal(t, PhaseGameOver, engine.Phase)
+	assert.NotNil(t, game.Winner())
+	assert.Equal(t, hands*tricksPerHand, tricks, "Expected five tricks every hand")
+	for _, player := range game.Players {
+		assert.Equal(t, 1, player.Wins+player.Losses)
+	}
+}
+
+func TestEngineRejectsOutOfTurnActions(t *testing.T) {
+	engine := NewEngine(CreateEuchreGame(CreatePlayers()))
+	engine.NewGame(false)
+	assert.Equal(t, PhaseBidding, engine.Phase)
+
+	notActive := (engine.Round.ActivePlayer + 1) % 4
+	assert.ErrorIs(t, engine.Bid(notActive, Pass, Spades), ErrNotYourTurn)
+	card := engine.Round.Players[engine.Round.ActivePlayer].CardMap.ToSlice()[0]
+	assert.ErrorIs(t, engine.Play(engine.Round.ActivePlayer, card), ErrWrongPhase)
+	assert.ErrorIs(t, engine.NextHand(), ErrWrongPhase)
+}
+
+// confusedBidder orders up without naming a suit, which the engine turns down
+type confusedBidder struct{ Basic }
+
+func (confusedBidder) Bid(view *PlayerView) (Call, Suit) { return OrderUp, Suit(-1) }
+
+func TestRunBotsReportsARejectedDecision(t *testing.T) {
+	players := CreateComputerPlayers()
+	for _, player := range players {
+		player.Strategy = confusedBidder{}
+	}
+	engine := NewEngine(CreateSeededGame(players, DefaultRules(), 4))
+	engine.NewGame(false)
+	seat := engine.ActiveSeat()
+
+	err := engine.RunBots()
+	assert.ErrorIs(t, err, ErrInvalidSuit)
+	assert.Contains(t, err.Error(), players[seat].Name)
+	assert.Equal(t, PhaseBidding, engine.Phase)
+	assert.Equal(t, seat, engine.ActiveSeat(), "Expected the seat to still be waiting to bid")
+}
+
+func TestEngineOrderUpMakesDealerDiscard(t *testing.T) {
+	engine := NewEngine(CreateEuchreGame(CreatePlayers()))
+	engine.NewGame(false)
+	upCard := engine.Round.UpCard()
+	dealer := engine.Round.Players[engine.Round.Dealer]
+
+	assert.NoError(t, engine.Bid(engine.Round.ActivePlayer, OrderUp, upCard.Suit))
+	assert.Equal(t, PhaseDiscard, engine.Phase)
+	assert.Equal(t, upCard.Suit, engine.Round.Trump)
+	assert.Len(t, dealer.CardMap.ToSlice(), 6)
+	assert.True(t, dealer.CardMap.HasInHand(upCard))
+
+	discard := dealer.CardMap.ToSlice()[0]
+	assert.ErrorIs(t, engine.Discard((engine.Round.Dealer+1)%4, discard), ErrNotDealer)
+	assert.NoError(t, engine.Discard(engine.Round.Dealer, discard))
+	assert.Len(t, dealer.CardMap.ToSlice(), 5)
+	assert.Equal(t, PhasePlay, engine.Phase)
+	assert.Equal(t, (engine.Round.Dealer+1)%4, engine.ActiveSeat(), "Expected left of the dealer to lead")
+}
+
+func TestEngineSecondRoundCannotCallTurnedDownSuit(t *testing.T) {
+	engine := NewEngine(CreateEuchreGame(CreatePlayers()))
+	engine.NewGame(false)
+	upCard := engine.Round.UpCard()
+	for i := 0; i < 4; i++ {
+		assert.NoError(t, engine.Bid(engine.Round.ActivePlayer, Pass, upCard.Suit))
+	}
+	assert.False(t, engine.FirstBiddingRound())
+	assert.ErrorIs(t, engine.Bid(engine.Round.ActivePlayer, OrderUp, upCard.Suit), ErrSuitTurnedDown)
+}
+
+func TestEngineTracksTrickInPlayOrder(t *testing.T) {
+	engine := NewEngine(CreateEuchreGame(CreatePlayers()))
+	engine.NewGame(false)
+	suit := engine.Round.UpCard().Suit
+	for i := 0; i < 4; i++ {
+		engine.Bid(engine.Round.ActivePlayer, Pass, suit)
+	}
+	called := (suit + 1) % 4
+	assert.NoError(t, engine.Bid(engine.Round.ActivePlayer, OrderUp, called))
+	assert.Equal(t, PhasePlay, engine.Phase)
+
+	lead := engine.ActiveSeat()
+	first := engine.Round.Players[lead].CardMap.ToSlice()[0]
+	assert.NoError(t, engine.Play(lead, first))
+	second := engine.LegalPlays(engine.ActiveSeat())[0]
+	assert.NoError(t, engine.Play(engine.ActiveSeat(), second))
+	assert.Equal(t, []*Card{first, second}, engine.CurrentTrick())
+	for _, p := range engine.Round.Players {
+		assert.True(t, p.CardMap.HasSeen(first), "Expected every player to see the played card")
+	}
+}
+
+func TestEngineRejectsRenege(t *testing.T) {
+	players := CreatePlayers()
+	game := CreateEuchreGame(players)
+	engine := NewEngine(game)
+	engine.NewGame(false)
+	round := engine.Round
+	round.Trump = Spades
+	round.Caller = players[0]
+	round.BeginPlay(OrderUp, Spades)
+	engine.Phase = PhasePlay
+
+	for _, p := range players {
+		p.InitCardMap()
+	}
+	lead := round.Lead
+	next := round.NextPlayer(lead)
+	players[lead].CardMap.AddToHand(NewCard(13, Hearts))
+	players[next].CardMap.AddToHand(NewCard(9, Hearts))
+	players[next].CardMap.AddToHand(NewCard(1, Clubs))
+
+	assert.NoError(t, engine.Play(lead, NewCard(13, Hearts)))
+	err := engine.Play(next, NewCard(1, Clubs))
+	var illegal *IllegalPlayError
+	assert.ErrorAs(t, err, &illegal)
+	assert.Equal(t, Hearts, illegal.LeadSuit)
+	assert.True(t, players[next].CardMap.HasInHand(NewCard(1, Clubs)), "Expected the illegal card to stay in hand")
+	assert.Equal(t, []*Card{NewCard(9, Hearts)}, engine.LegalPlays(next))
+	assert.NoError(t, engine.Play(next, NewCard(9, Hearts)))
+}
+
+func TestEngineAllowsRenegeWhenEnabled(t *testing.T) {
+	players := CreatePlayers()
+	game := CreateEuchreGame(players)
+	game.Rules.AllowReneges = true
+	engine := NewEngine(game)
+	engine.NewGame(false)
+	assert.True(t, engine.Round.rules().AllowReneges)
+	engine.Round.Caller = players[0]
+	engine.Round.BeginPlay(OrderUp, Spades)
+	engine.Phase = PhasePlay
+
+	for _, p := range players {
+		p.InitCardMap()
+	}
+	lead := engine.Round.Lead
+	next := engine.Round.NextPlayer(lead)
+	players[lead].CardMap.AddToHand(NewCard(13, Hearts))
+	players[next].CardMap.AddToHand(NewCard(9, Hearts))
+	players[next].CardMap.AddToHand(NewCard(1, Clubs))
+
+	assert.NoError(t, engine.Play(lead, NewCard(13, Hearts)))
+	assert.NoError(t, engine.Play(next, NewCard(1, Clubs)))
+	assert.Len(t, engine.Round.Plays, 2)
+	_, err := engine.CallRenege(lead)
+	assert.ErrorIs(t, err, ErrWrongPhase, "Expected reneges to be called after the hand")
+}
+
+func TestEngineLonerPlaysThreeCardTricks(t *testing.T) {
+	players := CreateComputerPlayers()
+	game := CreateEuchreGame(players)
+	engine := NewEngine(game)
+	engine.NewGame(false)
+	round := engine.Round
+
+	// The player left of the dealer goes alone, so their partner would have led
+	caller := round.ActivePlayer
+	partner := (caller + 2) % 4
+	suit := round.UpCard().Suit
+	assert.NoError(t, engine.Bid(caller, Alone, suit))
+	assert.True(t, round.Alone)
+	assert.True(t, round.SittingOut(partner))
+	assert.False(t, players[partner].IsPlaying)
+	assert.Equal(t, 3, round.PlayingCount())
+	assert.NotEqual(t, partner, round.Lead)
+
+	tricks := 0
+	engine.Subscribe(func(event Event) {
+		switch event.Type {
+		case EventPlay:
+			assert.NotEqual(t, partner, event.Seat, "Expected the loner's partner to sit out")
+		case EventTrickWon:
+			tricks++
+			assert.Nil(t, event.Trick[partner])
+			played := 0
+			for _, card := range event.Trick {
+				if card != nil {
+					played++
+				}
+			}
+			assert.Equal(t, 3, played)
+		}
+	})
+	assert.NoError(t, engine.RunBots())
+	assert.Equal(t, tricksPerHand, tricks)
+	assert.True(t, round.HandComplete())
+	assert.True(t, round.Result.Alone)
+	assert.Len(t, players[partner].CardMap.ToSlice(), 5, "Expected the partner's cards to go unplayed")
+}
+
+func TestDealerPartnerLonerLeavesUpCard(t *testing.T) {
+	engine := NewEngine(CreateEuchreGame(CreatePlayers()))
+	engine.NewGame(false)
+	round := engine.Round
+	for round.ActivePlayer != (round.Dealer+2)%4 {
+		assert.NoError(t, engine.Bid(round.ActivePlayer, Pass, Suit(-1)))
+	}
+	assert.NoError(t, engine.Bid(round.ActivePlayer, Alone, round.UpCard().Suit))
+	assert.True(t, round.SittingOut(round.Dealer))
+	assert.Equal(t, PhasePlay, engine.Phase, "Expected no discard from a dealer who is sitting out")
+	assert.Len(t, round.Players[round.Dealer].CardMap.ToSlice(), 5)
+}
+
+func TestThreeCardTrickWinner(t *testing.T) {
+	round := &Round{Players: CreatePlayers(), Trump: Hearts}
+	trick := []*Card{NewCard(10, Clubs), nil, NewCard(12, Clubs), NewCard(9, Hearts)}
+	assert.Equal(t, 3, round.DetermineTrickWinner(trick, 0))
+	trick = []*Card{NewCard(10, Clubs), nil, NewCard(12, Clubs), NewCard(9, Spades)}
+	assert.Equal(t, 2, round.DetermineTrickWinner(trick, 2))
+}
+
+func TestAceTakesTheTrick(t *testing.T) {
+	round := &Round{Players: CreatePlayers(), Trump: Spades}
+	trick := []*Card{NewCard(9, Hearts), NewCard(13, Hearts), NewCard(1, Hearts), NewCard(10, Hearts)}
+	assert.Equal(t, 2, round.DetermineTrickWinner(trick, 0))
+	trick = []*Card{NewCard(13, Hearts), NewCard(1, Spades), NewCard(1, Hearts), NewCard(9, Spades)}
+	assert.Equal(t, 1, round.DetermineTrickWinner(trick, 0), "Expected the ace of trump to beat the 9")
+}
+
+func TestComputerGoesAloneWithAStrongHand(t *testing.T) {
+	player := CreateTestPlayer("Loner", &Deck{Cards: []*Card{
+		NewCard(11, Hearts),
+		NewCard(11, Diamonds),
+		NewCard(1, Hearts),
+		NewCard(13, Hearts),
+		NewCard(1, Spades),
+	}})
+	assert.Equal(t, Alone, DefaultBidding().OrderUp(player.CardMap, ThirdSeat, NewCard(9, Hearts)))
+}
@@ -0,0 +1,206 @@
+package euchre
+
+import (
+	"fmt"
+	"math/rand"
+	"strconv"
+	"time"
+)
+
+type Game struct {
+	Players     []*Player
+	Teams       []*Team
+	Deck        *Deck
+	Suits       []Suit
+	Ranks       []int
+	Dealer      int
+	CardsToDeal int
+	Rounds      []*Round
+	Rules       Rules
+	ID          string     // identifies the game in bug reports, the seed written in hex
+	Seed        int64      // replaying the same decisions with the same seed reproduces every deal
+	Rand        *rand.Rand // every shuffle, dealer and seat choice comes from here
+	Shuffler    Shuffler   // how the deck is shuffled each hand, Fisher-Yates when nil
+	source      *countingSource
+}
+
+// countingSource counts the numbers drawn so a saved game can pick its seeded source up where it left off
+type countingSource struct {
+	rand.Source
+	draws uint64
+}
+
+func (source *countingSource) Int63() int64 {
+	source.draws++
+	return source.Source.Int63()
+}
+
+// skipTo draws and throws away numbers until the given count has been drawn
+func (source *countingSource) skipTo(draws uint64) {
+	for source.draws < draws {
+		source.Int63()
+	}
+}
+
+func CreateEuchreGame(players []*Player) *Game {
+	return CreateEuchreGameWithRules(players, DefaultRules())
+}
+
+// CreateEuchreGameWithRules sets up a game played by house rules, every round uses the same rules
+func CreateEuchreGameWithRules(players []*Player, rules Rules) *Game {
+	return CreateSeededGame(players, rules, time.Now().UnixNano())
+}
+
+// CreateSeededGame sets up a game whose deals are all decided by the seed
+func CreateSeededGame(players []*Player, rules Rules, seed int64) *Game {
+	game := &Game{
+		Players:     players,
+		CardsToDeal: tricksPerHand,
+		Ranks:       rules.Ranks(),
+		Suits:       []Suit{Spades, Diamonds, Clubs, Hearts},
+		Rules:       rules,
+		ID:          GameID(seed),
+		Seed:        seed,
+		source:      &countingSource{Source: rand.NewSource(seed)},
+	}
+	game.Rand = rand.New(game.source)
+	game.Deck = rules.NewDeck()
+	game.Teams = FormTeams(game.Players)
+	game.Dealer = game.Rand.Intn(len(game.Players))
+	return game
+}
+
+func GameID(seed int64) string {
+	return fmt.Sprintf("%016x", uint64(seed))
+}
+
+// ParseGameID turns a game ID from a bug report back into the seed that reproduces it
+func ParseGameID(id string) (int64, error) {
+	seed, err := strconv.ParseUint(id, 16, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid game id %q: %w", id, err)
+	}
+	return int64(seed), nil
+}
+
+func (game *Game) NewGame(changeTeams bool) {
+	if changeTeams {
+		game.RotateSeats()
+	}
+
+	// Clear all player hands
+	for _, player := range game.Players {
+		player.InitCardMap() // This clears the CardMap
+		player.TricksWon = 0
+	}
+	for _, team := range game.Teams {
+		team.ResetGame()
+	}
+
+	// Reset dealer and start new round
+	game.Dealer = game.Rand.Intn(len(game.Players))
+	game.NewRound()
+}
+
+func (game *Game) NewRound() {
+	game.Dealer++
+	if game.Dealer == len(game.Players) {
+		game.Dealer = 0
+	}
+
+	// Initialize player states
+	for _, player := range game.Players {
+		player.InitCardMap()
+		player.TricksWon = 0
+	}
+	for _, team := range game.Teams {
+		team.ResetHand()
+	}
+
+	// Create a new round with fresh state
+	round := &Round{
+		Players:        game.Players,
+		Teams:          game.Teams,
+		Dealer:         game.Dealer,
+		Deck:           game.Rules.NewDeck(),
+		Rules:          &game.Rules,
+		Rand:           game.Rand,
+		Shuffler:       game.Shuffler,
+		SelectingTrump: true,
+		ActivePlayer:   (game.Dealer + 1) % len(game.Players),
+	}
+	round.Begin()
+	game.Rounds = append(game.Rounds, round)
+}
+
+func (game *Game) EndRound() {
+	if game.SomeoneWon() {
+		game.RecordResults()
+		return
+	}
+	game.NewRound()
+}
+
+func (game *Game) ClearScores() {
+	for _, team := range game.Teams {
+		team.Score = 0
+	}
+}
+
+func (game *Game) RandomizeSeats() {
+	for seat := 0; seat < len(game.Players); seat++ {
+		swap := game.Rand.Intn(len(game.Players))
+		if swap != seat {
+			game.Players[swap], game.Players[seat] = game.Players[seat], game.Players[swap]
+		}
+	}
+	game.Teams = FormTeams(game.Players)
+	game.Dealer = game.Rand.Intn(len(game.Players))
+}
+
+func (game *Game) RotateSeats() {
+	// this is common in tournaments where 3 players will rotate seats to get new partners
+	players := game.Players
+	newSeats := make([]*Player, len(players))
+	newSeats[0] = players[0]              // First player stays in place
+	newSeats[1] = players[len(players)-1] // Last player moves to second seat
+	copy(newSeats[2:], players[1:len(players)-1])
+
+	game.Players = newSeats
+	game.Teams = FormTeams(game.Players)
+}
+
+// SwapSides moves every player one seat to the left. Partners stay together, unlike RotateSeats, but each team
+// sits where the other team sat, so a game replayed from the same seed deals each team the other team's cards.
+func (game *Game) SwapSides() {
+	players := make([]*Player, len(game.Players))
+	for seat, player := range game.Players {
+		players[(seat+1)%len(players)] = player
+	}
+	game.Players = players
+	game.Teams = FormTeams(game.Players)
+}
+
+func (game *Game) SomeoneWon() bool {
+	return game.Winner() != nil
+}
+
+func (game *Game) Winner() *Team {
+	for _, team := range game.Teams {
+		if team.Score >= game.Rules.ScoreLimit {
+			return team
+		}
+	}
+	return nil
+}
+
+func (game *Game) RecordResults() {
+	winner := game.Winner()
+	for _, player := range game.Players {
+		if player.Team == winner {
+			player.Wins++
+		} else {
+			player.Losses++
+		}
+	}
+}
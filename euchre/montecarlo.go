@@ -0,0 +1,202 @@
+package euchre
+
+import (
+	"math/rand"
+	"time"
+)
+
+// MonteCarlo picks its card by dealing the cards it can't see the way its Beliefs say they are likely to be,
+// and playing the rest of the hand out after each card it could play. It plays the card with the
+// best total over all the deals. Bidding and the dealer's discard are left to Basic.
+//
+// The choices only depend on the seed, unless the Budget runs out before all the samples are played.
+type MonteCarlo struct {
+	Samples int           // deals to try for each card played
+	Budget  time.Duration // stop sampling once a decision has taken this long, zero for no limit
+	rng     *rand.Rand
+}
+
+const MonteCarloSamples = 50
+
+func NewMonteCarlo(seed int64, samples int, budget time.Duration) *MonteCarlo {
+	return &MonteCarlo{Samples: samples, Budget: budget, rng: rand.New(rand.NewSource(seed))}
+}
+
+func (mc *MonteCarlo) Name() string {
+	return "montecarlo"
+}
+
+func (mc *MonteCarlo) Bid(view *PlayerView) (Call, Suit) {
+	return Basic{}.Bid(view)
+}
+
+func (mc *MonteCarlo) DefendAlone(view *PlayerView) bool {
+	return Basic{}.DefendAlone(view)
+}
+
+func (mc *MonteCarlo) Discard(view *PlayerView) *Card {
+	return Basic{}.Discard(view)
+}
+
+func (mc *MonteCarlo) Play(view *PlayerView) *Card {
+	legal := LegalPlays(view.Hand, view.CurrentTrick(), view.Trump)
+	if len(legal) == 1 {
+		return legal[0]
+	}
+	start := time.Now()
+	beliefs := view.Beliefs()
+	totals := make([]int, len(legal))
+	for sample := 0; sample < mc.Samples; sample++ {
+		if mc.Budget > 0 && sample > 0 && time.Since(start) > mc.Budget {
+			break
+		}
+		hands := beliefs.Sample(view, mc.rng)
+		for i, card := range legal {
+			totals[i] += playOut(view, hands, card)
+		}
+	}
+	best := 0
+	for i, total := range totals {
+		if total > totals[best] {
+			best = i
+		}
+	}
+	return legal[best]
+}
+
+// unseenCards are the cards the seat doesn't know the whereabouts of, in the other hands or the kitty
+func unseenCards(view *PlayerView) []*Card {
+	var unseen []*Card
+	for _, card := range view.Rules.NewDeck().Cards {
+		if !view.CardMap.HasInHand(card) && !view.CardMap.HasSeen(card) && !playedCard(view.Plays, card) {
+			unseen = append(unseen, card)
+		}
+	}
+	return unseen
+}
+
+func playedCard(plays []PlayRecord, card *Card) bool {
+	for _, play := range plays {
+		if play.Card.Rank == card.Rank && play.Card.Suit == card.Suit {
+			return true
+		}
+	}
+	return false
+}
+
+// voids works out which suits each seat has shown out of, by not following the suit led
+func voids(plays []PlayRecord, trump Suit) [4][4]bool {
+	var void [4][4]bool
+	var leadSuit Suit
+	for i, play := range plays {
+		if i == 0 || play.Trick != plays[i-1].Trick {
+			leadSuit = play.Card.EffectiveSuit(trump)
+			continue
+		}
+		if play.Card.EffectiveSuit(trump) != leadSuit && validSuit(leadSuit) {
+			void[play.Seat][leadSuit] = true
+		}
+	}
+	return void
+}
+
+// playOut finishes the hand with everyone playing like Basic once the seat has played the card.
+// The score is the points for the seat's team less the points against, with the tricks taken to break ties.
+func playOut(view *PlayerView, hands [4][]*Card, card *Card) int {
+	players := len(view.Players)
+	var cardMaps [4]CardMap
+	for seat := 0; seat < players; seat++ {
+		for _, c := range hands[seat] {
+			cardMaps[seat].AddToHand(c)
+		}
+	}
+	trickWinner := &Round{Trump: view.Trump}
+	var won [2]int
+	for _, done := range completedTricks(view.Plays, len(view.CurrentTrick())) {
+		won[trickWinner.DetermineTrickWinner(done.cards[:players], done.lead)%2]++
+	}
+
+	trick, lead, seat := view.Trick, view.Lead, view.Seat
+	playing := 0
+	for _, out := range view.SittingOut {
+		if !out {
+			playing++
+		}
+	}
+	play := card
+	for {
+		cardMaps[seat].RemoveFromHand(*play)
+		trick[seat] = play
+		played := 0
+		for _, c := range trick {
+			if c != nil {
+				played++
+			}
+		}
+		if played == playing {
+			lead = trickWinner.DetermineTrickWinner(trick[:players], lead)
+			won[lead%2]++
+			if won[0]+won[1] == tricksPerHand {
+				break
+			}
+			trick, seat = [4]*Card{}, lead
+		} else {
+			seat = nextPlaying(view, seat)
+		}
+		next := basicPlay(&PlayerView{Seat: seat, Players: view.Players, Trump: view.Trump, Caller: view.Caller,
+			Lead: lead, Trick: trick, CardMap: cardMaps[seat]})
+		play = &next
+	}
+	return handScore(view, won)
+}
+
+type playedTrick struct {
+	cards [4]*Card // by seat
+	lead  int
+}
+
+// completedTricks groups the plays into the tricks already taken, leaving out the cards still on the table
+func completedTricks(plays []PlayRecord, onTable int) []playedTrick {
+	var tricks []playedTrick
+	for i, play := range plays[:len(plays)-onTable] {
+		if i == 0 || play.Trick != plays[i-1].Trick {
+			tricks = append(tricks, playedTrick{lead: play.Seat})
+		}
+		tricks[len(tricks)-1].cards[play.Seat] = play.Card
+	}
+	return tricks
+}
+
+func nextPlaying(view *PlayerView, seat int) int {
+	for i := 1; i < len(view.Players); i++ {
+		next := (seat + i) % len(view.Players)
+		if !view.SittingOut[next] {
+			return next
+		}
+	}
+	return seat
+}
+
+// handScore is the hand's points for the seat's team less its points against, ten to a point,
+// plus the tricks the team took
+func handScore(view *PlayerView, won [2]int) int {
+	us := view.Seat % 2
+	makers := view.Caller % 2
+	points := 1
+	switch {
+	case won[makers] < 3:
+		points = -2
+		if view.Defender >= 0 {
+			points = -view.Rules.DefendPoints
+		}
+	case won[makers] == tricksPerHand:
+		points = 2
+		if view.Alone {
+			points = view.Rules.LonerPoints
+		}
+	}
+	if us != makers {
+		points = -points
+	}
+	return points*10 + won[us]
+}
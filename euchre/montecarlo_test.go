@@ -0,0 +1,111 @@
+package euchre
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func monteCarloGame(seed int64) *Engine {
+	players := CreateComputerPlayers()
+	players[0].Strategy = NewMonteCarlo(seed, 20, 0)
+	players[2].Strategy = NewMonteCarlo(seed+1, 20, 0)
+	engine := NewEngine(CreateSeededGame(players, DefaultRules(), seed))
+	engine.NewGame(false)
+	return engine
+}
+
+func TestMonteCarloIsRepeatableUnderASeed(t *testing.T) {
+	first, second := monteCarloGame(6), monteCarloGame(6)
+	playToEnd(t, first)
+	playToEnd(t, second)
+	assert.Equal(t, first.Recording.Actions, second.Recording.Actions)
+}
+
+func TestMonteCarloOutscoresBasic(t *testing.T) {
+	var scores [2]int
+	for seed := int64(1); seed <= 20; seed++ {
+		engine := monteCarloGame(seed)
+		playToEnd(t, engine)
+		for i, team := range engine.Game.Teams {
+			scores[i] += team.Score
+		}
+	}
+	assert.Greater(t, scores[0], scores[1], "Expected the Monte Carlo partners to score more over twenty games")
+}
+
+func TestSampledHandsAgreeWithWhatTheSeatHasSeen(t *testing.T) {
+	engine := NewEngine(CreateSeededGame(CreateComputerPlayers(), DefaultRules(), 12))
+	engine.NewGame(false)
+	for engine.Phase != PhasePlay || engine.Round.TricksPlayed < 2 || len(engine.CurrentTrick()) != 1 {
+		assert.True(t, step(t, engine))
+	}
+	seat := engine.ActiveSeat()
+	view := engine.View(seat)
+	void := voids(view.Plays, view.Trump)
+	mc := NewMonteCarlo(3, 1, 0)
+	beliefs := view.Beliefs()
+	for sample := 0; sample < 50; sample++ {
+		hands := beliefs.Sample(view, mc.rng)
+		assert.Equal(t, view.Hand, hands[seat])
+		for other := range view.Players {
+			assert.Len(t, hands[other], view.HandSizes[other])
+			if other == seat {
+				continue
+			}
+			for _, card := range hands[other] {
+				assert.False(t, ContainsCard(view.Hand, card), "Expected the seat's own cards to stay put")
+				assert.False(t, playedCard(view.Plays, card), "Expected played cards to stay played")
+			}
+		}
+	}
+	// Voids are kept whenever the deal allows it
+	for other := range view.Players {
+		for suit, out := range void[other] {
+			if !out {
+				continue
+			}
+			for _, card := range beliefs.Sample(view, mc.rng)[other] {
+				assert.NotEqual(t, Suit(suit), card.EffectiveSuit(view.Trump))
+			}
+		}
+	}
+}
+
+func TestVoidsComeFromNotFollowingSuit(t *testing.T) {
+	plays := []PlayRecord{
+		{Trick: 0, Seat: 1, Card: NewCard(1, Hearts)},
+		{Trick: 0, Seat: 2, Card: NewCard(9, Hearts)},
+		{Trick: 0, Seat: 3, Card: NewCard(9, Clubs)},
+		{Trick: 0, Seat: 0, Card: NewCard(11, Diamonds)}, // the left bower is a spade
+	}
+	void := voids(plays, Spades)
+	assert.True(t, void[3][Hearts])
+	assert.True(t, void[0][Hearts])
+	assert.False(t, void[2][Hearts])
+	assert.False(t, void[1][Hearts])
+}
+
+func TestPlayOutLetsTheAcesWin(t *testing.T) {
+	// North is alone with both bowers and three aces, nobody else holds trump so every trick is North's
+	hands := [4][]*Card{
+		{NewCard(11, Spades), NewCard(11, Clubs), NewCard(1, Hearts), NewCard(1, Clubs), NewCard(1, Diamonds)},
+		{NewCard(13, Hearts), NewCard(12, Hearts), NewCard(13, Clubs), NewCard(12, Clubs), NewCard(13, Diamonds)},
+		nil,
+		{NewCard(9, Hearts), NewCard(10, Hearts), NewCard(9, Clubs), NewCard(10, Clubs), NewCard(9, Diamonds)},
+	}
+	view := &PlayerView{
+		Seat:       0,
+		Players:    []string{"North", "East", "South", "West"},
+		Trump:      Spades,
+		Caller:     0,
+		Alone:      true,
+		Defender:   -1,
+		SittingOut: []bool{false, false, true, false},
+		Rules:      DefaultRules(),
+	}
+	march := handScore(view, [2]int{tricksPerHand, 0})
+	for _, lead := range hands[0] {
+		assert.Equal(t, march, playOut(view, hands, lead), "Expected a march leading the %s", cardName(lead))
+	}
+}
@@ -1,6 +1,4 @@
-package main
-
-import "fmt"
+package euchre
 
 type Call int
 
@@ -14,18 +12,16 @@ type Player struct {
 	Name           string
 	CardMap        CardMap
 	CardsInSuit    map[Suit]int
-	Score          int
+	Team           *Team
 	Wins           int
 	Losses         int
 	ComputerPlayer bool
+	Strategy       Strategy // how the computer plays the seat, Basic when nil
 	TricksWon      int
 	Position       int
 	IsPlaying      bool // for the loners
 }
 
-var minimumScore = 7
-var lonerScore = 10
-
 func (player *Player) PlayCard(card *Card) *Card {
 	player.CardMap.RemoveFromHand(*card)
 	return card
@@ -40,22 +36,6 @@ func (call Call) FriendlyCall() string {
 		return "Pass"
 	}
 }
-func (player *Player) CallOrPass(trump Suit, teamPickup bool) Call {
-	wScore := player.CardMap.GetWScore(trump)
-	//todo, sit if your to the left of the dealer and you're stronger in next
-	if teamPickup {
-		wScore += 2
-	} else {
-		wScore -= 2
-	}
-	return DetermineCall(wScore)
-}
-
-func (player *Player) DeclareTrump(unavailableSuit Suit) (Call, Suit) {
-	suit, score := player.CardMap.BestTrumpScore(unavailableSuit)
-	return DetermineCall(score), suit
-}
-
 func (player *Player) PickUp(card *Card) {
 	// Only add to hand if this is the correct player
 	if player.CardMap.CountSuit(card.Suit) < 5 { // Max 5 cards in Euchre
@@ -70,20 +50,13 @@ func (player *Player) chooseDiscard(card *Card) {
 	// Add new card first
 	player.CardMap.AddToHand(card)
 
-	// Then discard weakest card
+	// Then discard the card the planner picks, the card picked up is trump
 	cards := player.CardMap.ToSlice()
 	if len(cards) <= 5 {
 		return
 	}
 
-	var discard *Card
-	for _, c := range cards {
-		if discard == nil || c.Rank < discard.Rank {
-			discard = c
-		}
-	}
-
-	if discard != nil {
+	if discard := PlanDiscard(cards, card.Suit).Card; discard != nil {
 		player.CardMap.RemoveFromHand(*discard)
 	}
 }
@@ -96,63 +69,6 @@ func (player *Player) InitCardMap() {
 	player.TricksWon = 0
 }
 
-func DetermineCall(score int) Call {
-	if score >= lonerScore {
-		return OrderUp // todo declare a loner
-	} else if score >= minimumScore {
-		return OrderUp
-	}
-	return Pass
-}
-
-func (player *Player) BestPlay(currentTrick []*Card, round Round) Card {
-	if len(currentTrick) == 0 {
-		//we lead
-		trumpCards := player.CardMap.CardsInSuit(round.Trump)
-		if player.getPartner(round.Players) == round.Caller && len(trumpCards) > 0 {
-			return *player.CardMap.Sort(round.Trump, true)[0]
-		}
-		return *player.CardMap.getStrongestOffsuit(round.Trump)
-	}
-	leadSuit := currentTrick[0].Suit
-	winningCard, winningPlayer := getWinningCard(currentTrick, round.Players, round.Trump, leadSuit)
-	winningTeam := player.getPartner(round.Players) == winningPlayer
-
-	hand := player.CardMap.ToSlice()
-	playable := getPlayableCards(hand, leadSuit, round.Trump)
-	fmt.Printf("Cards for %s, trump is %s, lead suit is %s\n", player.Name, round.Trump.FriendlySuit(), leadSuit.FriendlySuit())
-	printPlayable(playable.inSuit, playable.trump, playable.other)
-	hasLeadSuit := len(playable.inSuit) > 0
-
-	if !hasLeadSuit {
-		if !winningTeam {
-			if len(playable.trump) > 0 {
-				betterTrump := getLowestWinningTrump(playable.trump, winningCard, round.Trump, leadSuit)
-				if betterTrump != nil {
-					return *betterTrump
-				}
-			}
-			return getLowest(playable.other, round.Trump)
-		} else {
-			shortSuit := findShortSuit(player.CardMap, round.Trump)
-			if shortSuit != -1 {
-				return getCardInSuit(player.CardMap, shortSuit, true)
-			}
-			return getLowest(playable.other, round.Trump)
-		}
-	} else {
-		if !winningTeam || isWeak(winningCard) {
-			winning := getStrongerThan(playable.inSuit, winningCard, round.Trump)
-			if len(winning) > 0 {
-				return getStrongest(winning, round.Trump)
-			}
-			return getLowest(playable.inSuit, round.Trump)
-		} else {
-			return getLowest(playable.inSuit, round.Trump)
-		}
-	}
-}
-
 func getLowestWinningTrump(cards []*Card, currentWinner *Card, trump Suit, lead Suit) *Card {
 	var winningTrumps []*Card
 	for _, c := range cards {
@@ -172,7 +88,7 @@ func getLowestWinningTrump(cards []*Card, currentWinner *Card, trump Suit, lead
 	return lowest
 }
 
-func getWinningCard(cards []*Card, players []*Player, trump Suit, lead Suit) (*Card, *Player) {
+func getWinningCard(cards []*Card, seats []int, trump Suit, lead Suit) (*Card, int) {
 	winning := cards[0]
 	position := 0
 	for i, card := range cards[1:] {
@@ -181,14 +97,40 @@ func getWinningCard(cards []*Card, players []*Player, trump Suit, lead Suit) (*C
 			position = i + 1
 		}
 	}
-	return winning, players[position]
+	return winning, seats[position]
+}
+
+// LegalPlays returns the cards in hand that may be played to the trick.
+// Players must follow the suit led if they can, with the left bower counting as trump.
+func LegalPlays(hand []*Card, trick []*Card, trump Suit) []*Card {
+	if len(trick) == 0 {
+		return hand
+	}
+	playable := getPlayableCards(hand, trick[0].EffectiveSuit(trump), trump)
+	if len(playable.inSuit) > 0 {
+		return playable.inSuit
+	}
+	return hand
+}
+
+func IsLegalPlay(card *Card, hand []*Card, trick []*Card, trump Suit) bool {
+	return ContainsCard(LegalPlays(hand, trick, trump), card)
+}
+
+func ContainsCard(cards []*Card, card *Card) bool {
+	for _, c := range cards {
+		if c.Rank == card.Rank && c.Suit == card.Suit {
+			return true
+		}
+	}
+	return false
 }
 
 func getPlayableCards(hand []*Card, lead Suit, trump Suit) (result struct{ inSuit, trump, other []*Card }) {
 	for _, c := range hand {
-		if c.Suit == lead || (c.Rank == 11 && c.SameColor(trump) && c.Suit != trump && lead == trump) {
+		if c.EffectiveSuit(trump) == lead {
 			result.inSuit = append(result.inSuit, c)
-		} else if c.Suit == trump || (c.Rank == 11 && c.SameColor(trump) && c.Suit != trump) {
+		} else if c.EffectiveSuit(trump) == trump {
 			result.trump = append(result.trump, c)
 		} else {
 			result.other = append(result.other, c)
@@ -197,23 +139,6 @@ func getPlayableCards(hand []*Card, lead Suit, trump Suit) (result struct{ inSui
 	return
 }
 
-func printPlayable(inSuit, trump, other []*Card ){
-	fmt.Println("In Suit");
-	for _, c := range inSuit {
-		fmt.Printf("Card is %v of %v\n", c.Rank, c.Suit.FriendlySuit())
-	}
-	fmt.Println("Trump");
-	for _, c := range trump {
-		fmt.Printf("Card is %v of %v\n", c.Rank, c.Suit.FriendlySuit())
-	}
-	fmt.Println("Other");
-	for _, c := range other {
-		fmt.Printf("Card is %v of %v\n", c.Rank, c.Suit.FriendlySuit())
-	}
-
-
-}
-
 func getStrongest(cards []*Card, trump Suit) Card {
 	strongest := cards[0]
 	for _, c := range cards[1:] {
@@ -269,6 +194,10 @@ func getCardInSuit(cardMap CardMap, suit Suit, lowest bool) Card {
 }
 
 func (player *Player) getPartner(players []*Player) *Player {
+	if player.Team != nil {
+		return player.Team.PartnerOf(player)
+	}
+	// Not seated at a game yet, partners sit across from each other
 	for i, p := range players {
 		if p == player {
 			if i > 1 {
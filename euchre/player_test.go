@@ -1,4 +1,4 @@
-package main
+package euchre
 
 import (
 	"testing"
@@ -37,8 +37,7 @@ func TestPassBadHand(t *testing.T){
 	newMap.AddToHand(card3)
 	newMap.AddToHand(card4)
 	newMap.AddToHand(card5)
-	p := Player{CardMap: *newMap}
-	actual := p.CallOrPass(Spades, true)
+	actual := DefaultBidding().OrderUp(*newMap, ThirdSeat, NewCard(9, Spades))
 	assert.Equal(t, Pass, actual)
 }
 func TestOrderGoodHand(t *testing.T){
@@ -54,8 +53,7 @@ func TestOrderGoodHand(t *testing.T){
 	newMap.AddToHand(card3)
 	newMap.AddToHand(card4)
 	newMap.AddToHand(card5)
-	p := Player{CardMap: *newMap}
-	actual := p.CallOrPass(Clubs, true)
+	actual := DefaultBidding().OrderUp(*newMap, ThirdSeat, NewCard(10, Clubs))
 	assert.Equal(t, OrderUp, actual)
 }
 
@@ -72,10 +70,9 @@ func TestOrderDependsOnPickup(t *testing.T){
 	newMap.AddToHand(card3)
 	newMap.AddToHand(card4)
 	newMap.AddToHand(card5)
-	p := Player{CardMap: *newMap}
-	actual := p.CallOrPass(Clubs, true)
+	actual := DefaultBidding().OrderUp(*newMap, ThirdSeat, NewCard(10, Clubs))
 	assert.Equal(t, OrderUp, actual)
-	actual = p.CallOrPass(Clubs, false)
+	actual = DefaultBidding().OrderUp(*newMap, SecondSeat, NewCard(10, Clubs))
 	assert.Equal(t, Pass, actual)
 }
 
@@ -113,7 +110,7 @@ func TestBestPlay_PlayerCanWinWithTrump(t *testing.T) {
 		Players: []*Player{player, opponent1, partner, opponent2},
 	}
 
-	best := player.BestPlay(currentTrick, round)
+	best := *Basic{}.Play(round.View(0, currentTrick))
 	expected:= *NewCard(11, Spades) 
 	assert.Equal(t,expected,best)
 }
@@ -159,7 +156,7 @@ func TestBestPlay_PlayerHasLeadSuit(t *testing.T) {
 		Players: []*Player{player, opponent1, partner, opponent2},
 	}
 
-	best := player.BestPlay(currentTrick, round)
+	best := *Basic{}.Play(round.View(0, currentTrick))
 	if best != *NewCard(10, Clubs) {
 		t.Errorf("Expected to follow suit with 10 of Clubs, got %+v", best)
 	}
@@ -202,9 +199,40 @@ func TestBestPlay_PlayerIsShortSuited(t *testing.T) {
 		Players: []*Player{player, opponent1, partner, opponent2},
 	}
 
-	best := player.BestPlay(currentTrick, round)
+	best := *Basic{}.Play(round.View(0, currentTrick))
 
 	if best.Suit != Spades {
 		t.Errorf("Expected to play trump to try to win, got %+v", best)
 	}
 }
+
+func TestLegalPlaysMustFollowSuit(t *testing.T) {
+	hand := []*Card{NewCard(9, Hearts), NewCard(1, Clubs), NewCard(10, Spades)}
+	trick := []*Card{NewCard(13, Hearts)}
+	legal := LegalPlays(hand, trick, Spades)
+	assert.Equal(t, []*Card{hand[0]}, legal)
+	assert.False(t, IsLegalPlay(hand[1], hand, trick, Spades))
+}
+
+func TestLegalPlaysAnyCardWhenVoid(t *testing.T) {
+	hand := []*Card{NewCard(1, Clubs), NewCard(10, Spades)}
+	trick := []*Card{NewCard(13, Hearts)}
+	assert.Equal(t, hand, LegalPlays(hand, trick, Spades))
+	assert.Equal(t, hand, LegalPlays(hand, nil, Spades), "Expected any card can be led")
+}
+
+func TestLegalPlaysLeftBowerIsTrump(t *testing.T) {
+	// Jack of clubs is the left bower when spades are trump
+	leftBower := NewCard(11, Clubs)
+	hand := []*Card{leftBower, NewCard(9, Hearts)}
+
+	clubsLed := []*Card{NewCard(1, Clubs)}
+	assert.Equal(t, hand, LegalPlays(hand, clubsLed, Spades), "Expected the left bower not to follow clubs")
+
+	trumpLed := []*Card{NewCard(10, Spades)}
+	assert.Equal(t, []*Card{leftBower}, LegalPlays(hand, trumpLed, Spades), "Expected the left bower to follow trump")
+
+	bowerLed := []*Card{NewCard(11, Clubs)}
+	hand = []*Card{NewCard(9, Spades), NewCard(10, Clubs)}
+	assert.Equal(t, []*Card{hand[0]}, LegalPlays(hand, bowerLed, Spades), "Expected a led left bower to call for trump")
+}
@@ -0,0 +1,274 @@
+package euchre
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+// A Recording is every action taken in a game, in order. The deals come from the game's seed,
+// so replaying the actions against a game created with the same seed rebuilds it exactly.
+// Recordings are written as one JSON document, or as JSON Lines with the header first and then one action per line.
+
+const recordingVersion = 1
+
+var (
+	ErrUnsupportedVersion = errors.New("unsupported recording version")
+	ErrReplayDiverged     = errors.New("the replay no longer matches the recording")
+)
+
+type ActionType string
+
+const (
+	ActionNewGame     ActionType = "newGame"
+	ActionDeal        ActionType = "deal" // recorded so a replay can check it dealt the same cards
+	ActionFarmersHand ActionType = "farmersHand"
+	ActionBid         ActionType = "bid"
+	ActionDefendAlone ActionType = "defendAlone"
+	ActionDiscard     ActionType = "discard"
+	ActionPlay        ActionType = "play"
+	ActionNextHand    ActionType = "nextHand"
+	ActionCallRenege  ActionType = "callRenege"
+)
+
+// Action is one entry in the log. Only the fields relevant to the Type are set.
+type Action struct {
+	Type        ActionType `json:"type"`
+	Seat        int        `json:"seat"`
+	Call        Call       `json:"call,omitempty"`
+	Suit        Suit       `json:"suit,omitempty"`
+	Card        *Card      `json:"card,omitempty"`
+	Cards       []*Card    `json:"cards,omitempty"` // the farmer's discards
+	Defend      bool       `json:"defend,omitempty"`
+	ChangeTeams bool       `json:"changeTeams,omitempty"`
+	Hands       [][]*Card  `json:"hands,omitempty"` // the deal, by seat
+	UpCard      *Card      `json:"upCard,omitempty"`
+}
+
+type RecordedPlayer struct {
+	Name     string `json:"name"`
+	Computer bool   `json:"computer"`
+}
+
+type Recording struct {
+	Version int              `json:"version"`
+	GameID  string           `json:"gameId"`
+	Seed    int64            `json:"seed"`
+	Rules   Rules            `json:"rules"`
+	Players []RecordedPlayer `json:"players"`
+	Actions []Action         `json:"actions,omitempty"`
+}
+
+// NewRecording starts an empty log for the game as it is now
+func NewRecording(game *Game) *Recording {
+	recording := &Recording{
+		Version: recordingVersion,
+		GameID:  game.ID,
+		Seed:    game.Seed,
+		Rules:   game.Rules,
+	}
+	for _, player := range game.Players {
+		recording.Players = append(recording.Players, RecordedPlayer{Name: player.Name, Computer: player.ComputerPlayer})
+	}
+	return recording
+}
+
+func (recording *Recording) Add(action Action) {
+	recording.Actions = append(recording.Actions, action)
+}
+
+func (recording *Recording) WriteJSON(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(recording)
+}
+
+func (recording *Recording) WriteJSONL(w io.Writer) error {
+	header := *recording
+	header.Actions = nil
+	encoder := json.NewEncoder(w)
+	if err := encoder.Encode(header); err != nil {
+		return err
+	}
+	for _, action := range recording.Actions {
+		if err := encoder.Encode(action); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ReadRecording reads either format, a JSON Lines file is a header followed by its actions
+func ReadRecording(r io.Reader) (*Recording, error) {
+	decoder := json.NewDecoder(r)
+	recording := &Recording{}
+	if err := decoder.Decode(recording); err != nil {
+		return nil, err
+	}
+	if recording.Version != recordingVersion {
+		return nil, fmt.Errorf("%w: %d", ErrUnsupportedVersion, recording.Version)
+	}
+	for {
+		var action Action
+		err := decoder.Decode(&action)
+		if err == io.EOF {
+			return recording, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		recording.Add(action)
+	}
+}
+
+// Save writes the recording to a file, as JSON Lines when the name ends in .jsonl
+func (recording *Recording) Save(path string) error {
+	file, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if strings.HasSuffix(path, ".jsonl") {
+		err = recording.WriteJSONL(file)
+	} else {
+		err = recording.WriteJSON(file)
+	}
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	return err
+}
+
+func LoadRecording(path string) (*Recording, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	return ReadRecording(file)
+}
+
+// dealAction records the cards just dealt
+func dealAction(round *Round) Action {
+	action := Action{Type: ActionDeal, Seat: round.Dealer}
+	if upCard := round.UpCard(); upCard != nil {
+		action.UpCard = &Card{Rank: upCard.Rank, Suit: upCard.Suit}
+	}
+	for _, player := range round.Players {
+		action.Hands = append(action.Hands, player.CardMap.ToSlice())
+	}
+	return action
+}
+
+// Replay rebuilds a recorded game one action at a time. Its players are all driven by the recording,
+// so the engine never makes a computer player's decision on its own.
+type Replay struct {
+	Recording *Recording
+	Engine    *Engine
+	Step      int // how many actions have been applied
+}
+
+func NewReplay(recording *Recording) *Replay {
+	replay := &Replay{Recording: recording}
+	replay.restart()
+	return replay
+}
+
+func (replay *Replay) restart() {
+	var players []*Player
+	for seat, recorded := range replay.Recording.Players {
+		players = append(players, &Player{Name: recorded.Name, Position: seat, IsPlaying: true})
+	}
+	game := CreateSeededGame(players, replay.Recording.Rules, replay.Recording.Seed)
+	replay.Engine = NewEngine(game)
+	replay.Step = 0
+}
+
+func (replay *Replay) Done() bool {
+	return replay.Step >= len(replay.Recording.Actions)
+}
+
+// Next applies the next recorded action, io.EOF means the recording has been played through
+func (replay *Replay) Next() error {
+	if replay.Done() {
+		return io.EOF
+	}
+	action := replay.Recording.Actions[replay.Step]
+	if err := replay.apply(action); err != nil {
+		return fmt.Errorf("action %d (%s): %w", replay.Step, action.Type, err)
+	}
+	replay.Step++
+	return nil
+}
+
+// Seek rebuilds the game as it was after the given number of actions
+func (replay *Replay) Seek(step int) error {
+	if step < replay.Step {
+		replay.restart()
+	}
+	for replay.Step < step {
+		if err := replay.Next(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (replay *Replay) apply(action Action) error {
+	e := replay.Engine
+	switch action.Type {
+	case ActionNewGame:
+		e.NewGame(action.ChangeTeams)
+		return nil
+	case ActionDeal:
+		return replay.checkDeal(action)
+	case ActionFarmersHand:
+		return e.FarmersExchange(action.Seat, action.Cards)
+	case ActionBid:
+		return e.Bid(action.Seat, action.Call, action.Suit)
+	case ActionDefendAlone:
+		return e.DefendAlone(action.Seat, action.Defend)
+	case ActionDiscard:
+		return e.Discard(action.Seat, action.Card)
+	case ActionPlay:
+		return e.Play(action.Seat, action.Card)
+	case ActionNextHand:
+		return e.NextHand()
+	case ActionCallRenege:
+		_, err := e.CallRenege(action.Seat)
+		return err
+	default:
+		return fmt.Errorf("unknown action %q", action.Type)
+	}
+}
+
+// checkDeal makes sure the replay dealt the recorded cards, a different shuffler or version would not
+func (replay *Replay) checkDeal(action Action) error {
+	dealt := dealAction(replay.Engine.Round)
+	if dealt.Seat != action.Seat || !sameCards([]*Card{dealt.UpCard}, []*Card{action.UpCard}) {
+		return ErrReplayDiverged
+	}
+	for seat, hand := range action.Hands {
+		if seat >= len(dealt.Hands) || !sameCards(dealt.Hands[seat], hand) {
+			return ErrReplayDiverged
+		}
+	}
+	return nil
+}
+
+func sameCards(a, b []*Card) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if (a[i] == nil) != (b[i] == nil) {
+			return false
+		}
+		if a[i] != nil && (a[i].Rank != b[i].Rank || a[i].Suit != b[i].Suit) {
+			return false
+		}
+	}
+	return true
+}
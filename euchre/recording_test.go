@@ -0,0 +1,96 @@
+package euchre
+
+import (
+	"bytes"
+	"io"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func recordGame(t *testing.T, seed int64) *Engine {
+	engine := NewEngine(CreateSeededGame(CreateComputerPlayers(), DefaultRules(), seed))
+	engine.NewGame(false)
+	playToEnd(t, engine)
+	return engine
+}
+
+func teamScores(game *Game) []int {
+	var scores []int
+	for _, team := range game.Teams {
+		scores = append(scores, team.Score)
+	}
+	return scores
+}
+
+func TestReplayRebuildsTheGame(t *testing.T) {
+	engine := recordGame(t, 99)
+	replay := NewReplay(engine.Recording)
+	for {
+		err := replay.Next()
+		if err == io.EOF {
+			break
+		}
+		assert.NoError(t, err)
+	}
+	assert.Equal(t, PhaseGameOver, replay.Engine.Phase)
+	assert.Equal(t, teamScores(engine.Game), teamScores(replay.Engine.Game))
+	assert.Equal(t, len(engine.Game.Rounds), len(replay.Engine.Game.Rounds))
+	assert.Equal(t, engine.Recording.Actions, replay.Engine.Recording.Actions)
+}
+
+func TestReplaySeeksBackwards(t *testing.T) {
+	engine := recordGame(t, 5)
+	replay := NewReplay(engine.Recording)
+	assert.NoError(t, replay.Seek(40))
+	hands := replay.Engine.Round.Players[0].CardMap.ToSlice()
+	trick := replay.Engine.CurrentTrick()
+
+	assert.NoError(t, replay.Seek(len(engine.Recording.Actions)))
+	assert.NoError(t, replay.Seek(40))
+	assert.Equal(t, 40, replay.Step)
+	assert.Equal(t, hands, replay.Engine.Round.Players[0].CardMap.ToSlice())
+	assert.Equal(t, trick, replay.Engine.CurrentTrick())
+}
+
+func TestRecordingRoundTripsThroughFiles(t *testing.T) {
+	engine := recordGame(t, 3)
+	dir := t.TempDir()
+	for _, name := range []string{"game.json", "game.jsonl"} {
+		path := filepath.Join(dir, name)
+		assert.NoError(t, engine.Recording.Save(path))
+		loaded, err := LoadRecording(path)
+		assert.NoError(t, err)
+		assert.Equal(t, engine.Game.ID, loaded.GameID)
+		assert.Len(t, loaded.Actions, len(engine.Recording.Actions))
+
+		replay := NewReplay(loaded)
+		assert.NoError(t, replay.Seek(len(loaded.Actions)), name)
+		assert.Equal(t, teamScores(engine.Game), teamScores(replay.Engine.Game), name)
+	}
+}
+
+func TestJSONLinesHasOneActionPerLine(t *testing.T) {
+	engine := recordGame(t, 3)
+	var buffer bytes.Buffer
+	assert.NoError(t, engine.Recording.WriteJSONL(&buffer))
+	lines := strings.Split(strings.TrimSpace(buffer.String()), "\n")
+	assert.Len(t, lines, len(engine.Recording.Actions)+1)
+	assert.Contains(t, lines[0], `"version":1`)
+	assert.Contains(t, lines[1], `"type":"newGame"`)
+}
+
+func TestReplayNoticesADifferentDeal(t *testing.T) {
+	recording := *recordGame(t, 3).Recording
+	recording.Seed = 4
+	replay := NewReplay(&recording)
+	assert.NoError(t, replay.Next())
+	assert.ErrorIs(t, replay.Next(), ErrReplayDiverged)
+}
+
+func TestReadRecordingRejectsOtherVersions(t *testing.T) {
+	_, err := ReadRecording(strings.NewReader(`{"version":2}`))
+	assert.ErrorIs(t, err, ErrUnsupportedVersion)
+}
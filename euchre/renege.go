@@ -0,0 +1,82 @@
+package euchre
+
+import "errors"
+
+var ErrNoRenege = errors.New("no renege can be proven against the other team")
+
+// PlayRecord is one card played during the hand, kept so a renege can be proven after the hand
+type PlayRecord struct {
+	Trick int   `json:"trick"`
+	Seat  int   `json:"seat"`
+	Card  *Card `json:"card"`
+}
+
+// Renege is a failure to follow suit, proven by the same player playing the led suit later in the hand
+type Renege struct {
+	Seat     int
+	Trick    int
+	Card     *Card // the card played instead of following suit
+	LeadSuit Suit
+	Proof    *Card // a card of the led suit the player still held
+}
+
+func (round *Round) RecordPlay(seat int, card *Card) {
+	round.Plays = append(round.Plays, PlayRecord{Trick: round.TricksPlayed, Seat: seat, Card: card})
+}
+
+// leadSuit is the suit led to the given trick
+func (round *Round) leadSuit(trick int) (Suit, bool) {
+	for _, play := range round.Plays {
+		if play.Trick == trick {
+			return play.Card.EffectiveSuit(round.Trump), true
+		}
+	}
+	return Suit(-1), false
+}
+
+// FindRenege looks through the recorded plays for a renege by the seat.
+// A card of the led suit played on a later trick shows the player held it and could have followed.
+func (round *Round) FindRenege(seat int) *Renege {
+	for i, play := range round.Plays {
+		if play.Seat != seat {
+			continue
+		}
+		lead, ok := round.leadSuit(play.Trick)
+		if !ok || play.Card.EffectiveSuit(round.Trump) == lead {
+			continue
+		}
+
+		// Everything the player showed after this trick was in their hand when they failed to follow
+		later := CardMap{}
+		for _, after := range round.Plays[i+1:] {
+			if after.Seat == seat && after.Trick > play.Trick {
+				later.MarkSeen(after.Card)
+			}
+		}
+		if proof := later.SeenInSuit(lead, round.Trump); proof != nil {
+			return &Renege{Seat: seat, Trick: play.Trick, Card: play.Card, LeadSuit: lead, Proof: proof}
+		}
+	}
+	return nil
+}
+
+// CallRenege lets the accuser call a renege on the other team once the hand is over.
+// A proven renege replaces the hand's result with the penalty.
+func (round *Round) CallRenege(accuser *Player) (*Renege, error) {
+	if round.Result == nil {
+		return nil, ErrWrongPhase
+	}
+	if round.Result.RenegedBy != nil {
+		return nil, ErrNoRenege // only one penalty per hand
+	}
+	for seat, player := range round.Players {
+		if player == accuser || player == accuser.getPartner(round.Players) {
+			continue
+		}
+		if renege := round.FindRenege(seat); renege != nil {
+			round.ApplyRenegePenalty(player)
+			return renege, nil
+		}
+	}
+	return nil, ErrNoRenege
+}
@@ -0,0 +1,88 @@
+package euchre
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// createRenegeRound sets up a finished hand where seat 1 failed to follow hearts on the first trick
+// and then played a heart on the second.
+func createRenegeRound() *Round {
+	players := CreatePlayers()
+	teams := FormTeams(players)
+	round := &Round{Players: players, Teams: teams, Caller: players[0], Trump: Spades}
+
+	round.RecordPlay(0, NewCard(13, Hearts))
+	round.RecordPlay(1, NewCard(9, Clubs)) // renege, still holds the nine of hearts
+	round.RecordPlay(2, NewCard(1, Hearts))
+	round.RecordPlay(3, NewCard(10, Hearts))
+	round.TricksPlayed = 1
+	round.RecordPlay(2, NewCard(12, Diamonds))
+	round.RecordPlay(3, NewCard(9, Diamonds))
+	round.RecordPlay(0, NewCard(10, Diamonds))
+	round.RecordPlay(1, NewCard(9, Hearts))
+	round.TricksPlayed = tricksPerHand
+
+	players[0].Team.TricksWon = 3
+	players[1].Team.TricksWon = 2
+	return round
+}
+
+func TestFindRenegeProvenByLaterPlay(t *testing.T) {
+	round := createRenegeRound()
+	renege := round.FindRenege(1)
+	assert.NotNil(t, renege)
+	assert.Equal(t, 0, renege.Trick)
+	assert.Equal(t, Hearts, renege.LeadSuit)
+	assert.Equal(t, *NewCard(9, Hearts), *renege.Proof)
+	assert.Nil(t, round.FindRenege(3))
+}
+
+func TestFindRenegeNeedsProof(t *testing.T) {
+	round := createRenegeRound()
+	round.Plays = round.Plays[:4] // never showed a heart afterwards
+	assert.Nil(t, round.FindRenege(1))
+}
+
+func TestLeftBowerIsNotARenege(t *testing.T) {
+	players := CreatePlayers()
+	round := &Round{Players: players, Caller: players[0], Trump: Spades}
+	round.RecordPlay(0, NewCard(1, Clubs))
+	round.RecordPlay(1, NewCard(9, Hearts))
+	round.TricksPlayed = 1
+	round.RecordPlay(1, NewCard(11, Clubs)) // left bower is a spade, not a club
+	assert.Nil(t, round.FindRenege(1))
+}
+
+func TestCallRenegeAppliesPenalty(t *testing.T) {
+	round := createRenegeRound()
+	result := round.ScoreHand()
+	assert.Equal(t, 1, round.Players[0].Team.Score)
+
+	_, err := round.CallRenege(round.Players[1])
+	assert.ErrorIs(t, err, ErrNoRenege, "Expected no renege by the makers")
+
+	renege, err := round.CallRenege(round.Players[2])
+	assert.NoError(t, err)
+	assert.Equal(t, 1, renege.Seat)
+	assert.Equal(t, 2, round.Players[0].Team.Score, "Expected the made point to be replaced by the penalty")
+	assert.Equal(t, 0, round.Players[1].Team.Score)
+	assert.Same(t, round.Players[1], round.Result.RenegedBy)
+	assert.NotSame(t, result, round.Result)
+	assert.Same(t, round.Result, round.Players[0].Team.History[0])
+
+	_, err = round.CallRenege(round.Players[2])
+	assert.ErrorIs(t, err, ErrNoRenege, "Expected only one penalty per hand")
+	assert.Equal(t, 2, round.Players[0].Team.Score)
+}
+
+func TestRenegeByMakersGoesToDefenders(t *testing.T) {
+	round := createRenegeRound()
+	round.Caller = round.Players[1]
+	round.Alone = true
+	round.ScoreHand()
+	round.CallRenege(round.Players[0])
+	assert.Equal(t, 2, round.Players[0].Team.Score, "Expected defenders to score two even against a loner")
+	assert.Equal(t, 0, round.Players[1].Team.Score)
+}
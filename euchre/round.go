@@ -0,0 +1,337 @@
+package euchre
+
+import (
+	"math/rand"
+	"time"
+)
+
+type Round struct {
+	Players        []*Player
+	Teams          []*Team
+	Dealer         int
+	Caller         *Player
+	TricksWon      int
+	Deck           *Deck
+	Trump          Suit
+	Turn           int
+	Lead           int
+	Alone          bool
+	SelectingTrump bool
+	ActivePlayer   int
+	TricksPlayed   int
+	Result         *HandResult
+	Plays          []PlayRecord
+	Rules          *Rules
+	Rand           *rand.Rand // the game's source, so the deal can be reproduced
+	Shuffler       Shuffler
+	Farmed         bool    // the kitty has been used for a farmer's hand
+	PassedOut      bool    // everyone passed twice and the hand was thrown in
+	Defender       *Player // a defender going alone against the loner
+	DefenseOffered int     // how many defenders have decided whether to defend alone
+	TurnedUp       *Card   // the card turned up for bidding, everyone saw it whatever became of it
+	Bids           []BidRecord
+}
+
+// BidRecord is one decision during trump selection, everyone at the table hears it
+type BidRecord struct {
+	Seat       int  `json:"seat"`
+	Call       Call `json:"call"`
+	Suit       Suit `json:"suit"` // the up card's suit in the first round, -1 for a pass in the second
+	FirstRound bool `json:"firstRound"`
+}
+
+func (round *Round) Begin() {
+	// Ensure we have a valid deck with cards
+	if round.Deck == nil || len(round.Deck.Cards) == 0 {
+		round.Deck = round.rules().NewDeck()
+	}
+	round.SelectingTrump = true
+	round.Deck.ShuffleUsing(round.shuffler(), round.random())
+	round.ActivePlayer = (round.Dealer + 1) % 4
+	round.Deal()
+}
+
+func (round *Round) Deal() {
+	// Ensure we have enough cards to deal (5 cards to each of 4 players = 20 cards)
+	if len(round.Deck.Cards) < tricksPerHand*len(round.Players) {
+		round.Deck = round.rules().NewDeck()
+		round.Deck.ShuffleUsing(round.shuffler(), round.random())
+	}
+
+	// Deal 5 cards to each player
+	for _, player := range round.Players {
+		cards := round.Deck.DealQuantity(tricksPerHand)
+		if len(cards.Cards) < tricksPerHand {
+			panic("Not enough cards in deck to deal")
+		}
+		player.CardMap.AddCardsToHand(cards)
+	}
+
+	// A suit has to be turned up, so the joker is tucked under the up card
+	if len(round.Deck.Cards) > 1 && round.Deck.Cards[0].IsJoker() {
+		round.Deck.Cards[0], round.Deck.Cards[1] = round.Deck.Cards[1], round.Deck.Cards[0]
+	}
+
+	// Set the top card face up
+	if len(round.Deck.Cards) > 0 {
+		round.Deck.Cards[0].TurnFaceUp()
+		turnedUp := *round.Deck.Cards[0]
+		round.TurnedUp = &turnedUp
+	}
+}
+
+// random is the round's source of randomness, a round set up without a game gets its own
+func (round *Round) random() *rand.Rand {
+	if round.Rand == nil {
+		round.Rand = rand.New(rand.NewSource(time.Now().UnixNano()))
+	}
+	return round.Rand
+}
+
+func (round *Round) shuffler() Shuffler {
+	if round.Shuffler == nil {
+		return FisherYates{}
+	}
+	return round.Shuffler
+}
+
+// DetermineTrump lets the computer players bid until trump is called, the hand is thrown in,
+// or it's a human's turn to decide.
+func (round *Round) DetermineTrump() {
+	round.SelectingTrump = true
+	for round.SelectingTrump {
+		if !round.Players[round.ActivePlayer].ComputerPlayer {
+			return // Wait for the human to make a selection
+		}
+		call, suit := round.ComputerBid(round.ActivePlayer)
+		round.Bid(call, suit)
+	}
+	if round.Caller != nil && round.Players[round.Dealer].ComputerPlayer {
+		round.ComputerDealerDiscard()
+	}
+}
+
+// ComputerBid is a computer player's decision in the current round of bidding, from what the seat can see
+func (round *Round) ComputerBid(seat int) (Call, Suit) {
+	return round.Players[seat].strategy().Bid(round.View(seat, nil))
+}
+
+// DealerIsStuck is true when playing stick the dealer and everyone else has passed twice
+func (round *Round) DealerIsStuck() bool {
+	upCard := round.UpCard()
+	return round.rules().StickTheDealer && round.SelectingTrump && round.ActivePlayer == round.Dealer &&
+		upCard != nil && !upCard.FaceUp
+}
+
+// UpCard is the top card of the kitty, face up while it can still be ordered
+func (round *Round) UpCard() *Card {
+	if round.Deck == nil || len(round.Deck.Cards) == 0 {
+		return nil
+	}
+	return round.Deck.Cards[0]
+}
+
+// Bid applies the active player's decision during trump selection and moves the bidding along.
+// If everyone passes twice the hand is passed out, unless the dealer is stuck and can't pass.
+func (round *Round) Bid(call Call, suit Suit) {
+	upCard := round.UpCard()
+	firstRound := upCard != nil && upCard.FaceUp
+
+	if call == Pass && round.DealerIsStuck() {
+		return
+	}
+	record := BidRecord{Seat: round.ActivePlayer, Call: call, Suit: suit, FirstRound: firstRound}
+	if firstRound {
+		record.Suit = upCard.Suit
+	} else if call == Pass {
+		record.Suit = Suit(-1)
+	}
+	round.Bids = append(round.Bids, record)
+
+	if call == Pass {
+		if round.ActivePlayer == round.Dealer {
+			if firstRound {
+				// Everyone passed on the up card, turn it down and go around again
+				upCard.TurnFaceDown()
+			} else {
+				// Throw the hand in
+				round.PassedOut = true
+				round.SelectingTrump = false
+				return
+			}
+		}
+		round.ActivePlayer = (round.ActivePlayer + 1) % len(round.Players)
+		return
+	}
+
+	round.Caller = round.Players[round.ActivePlayer]
+	round.Alone = call == Alone
+	if firstRound {
+		suit = upCard.Suit
+		if !round.SittingOut(round.Dealer) {
+			// The dealer picks up the up card and will have to discard
+			round.Players[round.Dealer].CardMap.AddToHand(upCard)
+			round.Deck.Cards = round.Deck.Cards[1:]
+		}
+	}
+	round.BeginPlay(call, suit)
+}
+
+// HasFarmersHand is true when the rules allow it and the seat was dealt nothing above a ten
+func (round *Round) HasFarmersHand(seat int) bool {
+	if !round.rules().FarmersHand || round.Farmed {
+		return false
+	}
+	for _, card := range round.Players[seat].CardMap.ToSlice() {
+		if card.IsJoker() || card.Rank == 1 || card.Rank > 10 {
+			return false
+		}
+	}
+	return true
+}
+
+// FarmersExchange swaps three cards from a farmer's hand for the face down cards of the kitty
+func (round *Round) FarmersExchange(seat int, cards []*Card) {
+	player := round.Players[seat]
+	kitty := round.Deck.Cards[1:4]
+	for i, card := range cards {
+		player.CardMap.RemoveFromHand(*card)
+		player.CardMap.AddToHand(kitty[i])
+		kitty[i] = card
+	}
+	round.Farmed = true
+}
+
+func (round *Round) DealerMustDiscard() bool {
+	return len(round.Players[round.Dealer].CardMap.ToSlice()) > tricksPerHand
+}
+
+// Discard removes a card from the dealer's hand after picking up
+func (round *Round) Discard(card *Card) {
+	round.Players[round.Dealer].CardMap.RemoveFromHand(*card)
+}
+
+func (round *Round) ComputerDealerDiscard() {
+	dealer := round.Players[round.Dealer]
+	if len(dealer.CardMap.ToSlice()) <= 5 {
+		return // No need to discard
+	}
+
+	if discard := round.DealerDiscardChoice(); discard != nil {
+		dealer.CardMap.RemoveFromHand(*discard)
+	}
+}
+
+func (round *Round) DealerDiscardChoice() *Card {
+	return round.Players[round.Dealer].strategy().Discard(round.View(round.Dealer, nil))
+}
+
+func (round *Round) BeginPlay(call Call, trump Suit) {
+	round.SelectingTrump = false
+	round.Alone = call == Alone
+	round.Trump = trump
+
+	round.seatPlayers()
+
+	if len(round.Deck.Cards) > 0 {
+		round.Deck.Cards[0].TurnFaceDown()
+	}
+}
+
+// seatPlayers marks who is playing the hand and who leads
+func (round *Round) seatPlayers() {
+	// Handle "going alone", the loner's partner sits the hand out
+	for seat, p := range round.Players {
+		p.IsPlaying = !round.SittingOut(seat)
+	}
+
+	// Left of dealer leads first trick, or the next player if they're sitting out
+	round.Lead = round.NextPlayer(round.Dealer)
+	round.ActivePlayer = round.Lead
+}
+
+// SittingOut is true for the partner of a player going alone, or of a defender going alone against them
+func (round *Round) SittingOut(seat int) bool {
+	if !round.Alone || round.Caller == nil {
+		return false
+	}
+	player := round.Players[seat]
+	if round.Caller.getPartner(round.Players) == player {
+		return true
+	}
+	return round.Defender != nil && round.Defender.getPartner(round.Players) == player
+}
+
+// PlayingCount is how many cards make up a trick this hand
+func (round *Round) PlayingCount() int {
+	count := 0
+	for seat := range round.Players {
+		if !round.SittingOut(seat) {
+			count++
+		}
+	}
+	return count
+}
+
+// NextPlayer is the next seat to the left that is playing this hand
+func (round *Round) NextPlayer(seat int) int {
+	for i := 1; i < len(round.Players); i++ {
+		next := (seat + i) % len(round.Players)
+		if !round.SittingOut(next) {
+			return next
+		}
+	}
+	return seat
+}
+
+// trickPlayers lists who played the cards already in the trick, in order, given the player who is next
+func (round *Round) trickPlayers(next *Player, played int) []*Player {
+	seat := 0
+	for i, p := range round.Players {
+		if p == next {
+			seat = i
+		}
+	}
+	players := make([]*Player, played)
+	for i := played - 1; i >= 0; i-- {
+		seat = round.previousPlayer(seat)
+		players[i] = round.Players[seat]
+	}
+	return players
+}
+
+func (round *Round) previousPlayer(seat int) int {
+	for i := 1; i < len(round.Players); i++ {
+		previous := (seat - i + len(round.Players)) % len(round.Players)
+		if !round.SittingOut(previous) {
+			return previous
+		}
+	}
+	return seat
+}
+
+// OnSameTeam reports whether the players in the two seats are partners
+func (round *Round) OnSameTeam(seatA, seatB int) bool {
+	a, b := round.Players[seatA], round.Players[seatB]
+	return a == b || a.getPartner(round.Players) == b
+}
+
+// DetermineTrickWinner takes the trick by seat, with no card for a seat sitting out the hand
+func (r *Round) DetermineTrickWinner(trick []*Card, lead int) int {
+	winningIndex := lead
+	winningCard := trick[lead]
+	leadSuit := trick[lead].EffectiveSuit(r.Trump)
+
+	for i := 1; i < len(trick); i++ {
+		pos := (lead + i) % len(trick)
+		card := trick[pos]
+		if card == nil {
+			continue
+		}
+		if card.Beats(winningCard, r.Trump, leadSuit) {
+			winningCard = card
+			winningIndex = pos
+		}
+	}
+	return winningIndex
+}
@@ -0,0 +1,147 @@
+package euchre
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFirstRoundDealsCorrectly(t *testing.T) {
+	players := CreatePlayers()
+	game := CreateEuchreGame(players)
+	game.NewRound()
+	round := game.Rounds[len(game.Rounds)-1]
+	assert.Equal(t,cardsToDeal,game.CardsToDeal)
+
+	for _, player := range round.Players {
+		hand := player.CardMap.ToSlice()
+		assert.Equal(t,len(hand), cardsToDeal, "Expected players to be dealt 5 cards")
+	}
+	assert.NotEqual(t, round.Players[0].CardMap, round.Players[1].CardMap)
+	totalDealt := (game.CardsToDeal * len(players))
+	expectedRemaining := expectedEuchreDeckSize - (cardsToDeal * len(round.Players))
+	assert.Equal(t,expectedEuchreDeckSize,totalDealt + len(round.Deck.Cards))
+	assert.Equal(t,expectedRemaining, len(round.Deck.Cards))
+}
+
+func TestNewRoundDealsCorrectly(t *testing.T) {
+	game := CreateEuchreGame(CreatePlayers())
+	game.NewRound()
+	round := game.Rounds[len(game.Rounds)-1]
+	for _, player := range round.Players {
+		hand := player.CardMap.ToSlice()
+		for _, card := range hand {
+			player.PlayCard(card)
+		}
+		hand = player.CardMap.ToSlice()
+		assert.Equal(t, 0, len(hand), "expected empty hands")
+	}
+	game.NewRound()
+	for _, player := range round.Players {
+		hand := player.CardMap.ToSlice()
+		assert.Equal(t,len(hand), cardsToDeal, "Expected players to be dealt 5 cards")
+	}
+	assert.True(t,round.Deck.Cards[0].FaceUp)
+}
+func TestNewRoundScoreStartsAtZero(t *testing.T){
+	game := CreateEuchreGame(CreatePlayers())
+	for i, team := range game.Teams {
+		team.Score = i+1
+		assert.NotEqual(t,team.Score, 0 , "Score should increment")
+	}
+	game.NewRound()
+	round := game.Rounds[len(game.Rounds)-1]
+	for _, player := range round.Players{
+		assert.NotEqual(t, player.Team.Score, 0 , "Expected score to persist for new rounds")
+	}
+}
+
+func TestDeclareTrumpDiscardsCorrectly(t *testing.T) {
+	game := CreateEuchreGame(CreatePlayers())
+	game.NewRound()
+	round := game.Rounds[len(game.Rounds)-1]
+	assert.Equal(t,cardsToDeal,game.CardsToDeal)
+	for _, player := range round.Players {
+		hand := player.CardMap.ToSlice()
+		assert.Equal(t,len(hand), cardsToDeal, "Expected players to be dealt 5 cards")
+	}
+	round.DetermineTrump()
+	for _, player := range round.Players {
+		hand := player.CardMap.ToSlice()
+		assert.Equal(t,cardsToDeal,len(hand), "Expected players to still have 5 cards after trump declared")
+	}
+}
+func passAround(t *testing.T, engine *Engine, times int) {
+	for i := 0; i < times; i++ {
+		assert.NoError(t, engine.Bid(engine.Round.ActivePlayer, Pass, Suit(-1)))
+	}
+}
+
+func TestThrowInRedealsToTheLeft(t *testing.T) {
+	game := CreateEuchreGame(CreatePlayers())
+	engine := NewEngine(game)
+	engine.NewGame(false)
+	passedOut := engine.Round
+	dealer := game.Dealer
+
+	passAround(t, engine, 8)
+	assert.True(t, passedOut.PassedOut)
+	assert.Nil(t, passedOut.Caller)
+	assert.Contains(t, game.Rounds, passedOut, "Expected the passed out hand to be recorded")
+	assert.NotSame(t, passedOut, engine.Round)
+	assert.Equal(t, (dealer+1)%4, game.Dealer, "Expected the deal to pass to the left")
+	assert.Equal(t, PhaseBidding, engine.Phase)
+	assert.True(t, engine.FirstBiddingRound())
+}
+
+func TestStickTheDealer(t *testing.T) {
+	rules := DefaultRules()
+	rules.StickTheDealer = true
+	game := CreateEuchreGameWithRules(CreatePlayers(), rules)
+	engine := NewEngine(game)
+	engine.NewGame(false)
+	round := engine.Round
+
+	passAround(t, engine, 7)
+	assert.Equal(t, round.Dealer, round.ActivePlayer)
+	assert.True(t, round.DealerIsStuck())
+	assert.ErrorIs(t, engine.Bid(round.Dealer, Pass, Suit(-1)), ErrDealerStuck)
+	assert.Same(t, round, engine.Round, "Expected no redeal")
+
+	suit := (round.UpCard().Suit + 1) % 4
+	assert.NoError(t, engine.Bid(round.Dealer, OrderUp, suit))
+	assert.Same(t, round.Players[round.Dealer], round.Caller)
+	assert.False(t, round.PassedOut)
+}
+
+// passingPlayers are computer players who never think a hand is good enough to call
+func passingPlayers() []*Player {
+	never := BiddingConfig{Order: [4]int{100, 100, 100, 100}, Name: [4]int{100, 100, 100, 100}, Alone: 100}
+	players := CreateComputerPlayers()
+	for _, player := range players {
+		player.Strategy = Basic{Bidding: &never}
+	}
+	return players
+}
+
+func TestComputerDealerCallsWhenStuck(t *testing.T) {
+	rules := DefaultRules()
+	rules.StickTheDealer = true
+	// Nobody wants to call anything
+	game := CreateEuchreGameWithRules(passingPlayers(), rules)
+	game.NewRound()
+	round := game.Rounds[len(game.Rounds)-1]
+	round.DetermineTrump()
+	assert.False(t, round.SelectingTrump)
+	assert.False(t, round.PassedOut)
+	assert.Same(t, round.Players[round.Dealer], round.Caller)
+}
+
+func TestComputersThrowInWithoutStickTheDealer(t *testing.T) {
+	game := CreateEuchreGame(passingPlayers())
+	game.NewRound()
+	round := game.Rounds[len(game.Rounds)-1]
+	round.DetermineTrump()
+	assert.True(t, round.PassedOut)
+	assert.Nil(t, round.Caller)
+}
@@ -0,0 +1,98 @@
+package euchre
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+)
+
+// Rules holds the house rules for a game. Every Round of the game plays by the same Rules.
+type Rules struct {
+	ScoreLimit     int  `json:"scoreLimit"`
+	StickTheDealer bool `json:"stickTheDealer"` // the dealer must call if everyone passes twice, otherwise the hand is thrown in
+	LonerPoints    int  `json:"lonerPoints"`    // points for taking all five tricks alone
+	DefendAlone    bool `json:"defendAlone"`    // a defender may go alone against a loner
+	DefendPoints   int  `json:"defendPoints"`   // points for a lone defender euchring a loner
+	FarmersHand    bool `json:"farmersHand"`    // a hand of nines and tens may be swapped with the kitty
+	NoTrump        bool `json:"noTrump"`        // no trump may be called in the second round of bidding
+	DeckSize       int  `json:"deckSize"`       // 24, 25 (with the joker), 28 or 32 cards
+	AllowReneges   bool `json:"allowReneges"`   // play continues after a renege so it can be called once the hand is over
+}
+
+var ErrInvalidRules = errors.New("invalid rules")
+
+func DefaultRules() Rules {
+	return Rules{
+		ScoreLimit:   10,
+		LonerPoints:  4,
+		DefendPoints: 4,
+		DeckSize:     24,
+	}
+}
+
+func (rules Rules) Validate() error {
+	if rules.ScoreLimit <= 0 {
+		return fmt.Errorf("%w: score limit must be positive", ErrInvalidRules)
+	}
+	if rules.LonerPoints <= 0 {
+		return fmt.Errorf("%w: loner points must be positive", ErrInvalidRules)
+	}
+	if rules.DefendAlone && rules.DefendPoints <= 0 {
+		return fmt.Errorf("%w: defend alone points must be positive", ErrInvalidRules)
+	}
+	switch rules.DeckSize {
+	case 24, 25, 28, 32:
+		return nil
+	default:
+		return fmt.Errorf("%w: a %d card deck isn't supported", ErrInvalidRules, rules.DeckSize)
+	}
+}
+
+// ReadRules reads house rules written as JSON, anything left out keeps its default
+func ReadRules(r io.Reader) (Rules, error) {
+	rules := DefaultRules()
+	if err := json.NewDecoder(r).Decode(&rules); err != nil {
+		return Rules{}, err
+	}
+	return rules, rules.Validate()
+}
+
+// LoadRules reads the house rules from a file like {"deckSize": 25, "stickTheDealer": true}
+func LoadRules(path string) (Rules, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return Rules{}, err
+	}
+	defer file.Close()
+	return ReadRules(file)
+}
+
+// Ranks are the ranks of each suit in the deck, the joker of the 25 card deck isn't a rank
+func (rules Rules) Ranks() []int {
+	switch rules.DeckSize {
+	case 28:
+		return []int{1, 8, 9, 10, 11, 12, 13}
+	case 32:
+		return []int{1, 7, 8, 9, 10, 11, 12, 13}
+	default:
+		return []int{1, 9, 10, 11, 12, 13}
+	}
+}
+
+func (rules Rules) NewDeck() *Deck {
+	deck := NewSpecificDeck(rules.Ranks(), []Suit{Spades, Diamonds, Clubs, Hearts})
+	if rules.DeckSize == 25 {
+		deck.Cards = append(deck.Cards, NewJoker())
+	}
+	return deck
+}
+
+// rules returns the round's rules, falling back to the defaults for a round set up without a game
+func (round *Round) rules() Rules {
+	if round.Rules == nil {
+		return DefaultRules()
+	}
+	return *round.Rules
+}
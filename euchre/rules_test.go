@@ -0,0 +1,144 @@
+package euchre
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDefaultRulesMatchStandardEuchre(t *testing.T) {
+	rules := DefaultRules()
+	assert.NoError(t, rules.Validate())
+	assert.Equal(t, 10, rules.ScoreLimit)
+	assert.Equal(t, 4, rules.LonerPoints)
+	assert.Len(t, rules.NewDeck().Cards, expectedEuchreDeckSize)
+}
+
+func TestDeckSizes(t *testing.T) {
+	for _, size := range []int{24, 25, 28, 32} {
+		rules := DefaultRules()
+		rules.DeckSize = size
+		assert.NoError(t, rules.Validate())
+		assert.Len(t, rules.NewDeck().Cards, size)
+	}
+	rules := DefaultRules()
+	rules.DeckSize = 52
+	assert.ErrorIs(t, rules.Validate(), ErrInvalidRules)
+}
+
+func TestReadRulesKeepsTheDefaults(t *testing.T) {
+	rules, err := ReadRules(strings.NewReader(`{"deckSize": 25, "noTrump": true}`))
+	assert.NoError(t, err)
+	assert.Equal(t, 25, rules.DeckSize)
+	assert.True(t, rules.NoTrump)
+	assert.Equal(t, DefaultRules().ScoreLimit, rules.ScoreLimit, "Expected what the file leaves out to keep its default")
+
+	_, err = ReadRules(strings.NewReader(`{"deckSize": 52}`))
+	assert.ErrorIs(t, err, ErrInvalidRules)
+	_, err = ReadRules(strings.NewReader(`{"deckSize":`))
+	assert.Error(t, err)
+}
+
+func TestLoadRules(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "rules.json")
+	assert.NoError(t, os.WriteFile(path, []byte(`{"allowReneges": true, "scoreLimit": 11}`), 0o644))
+	rules, err := LoadRules(path)
+	assert.NoError(t, err)
+	assert.True(t, rules.AllowReneges)
+	assert.Equal(t, 11, rules.ScoreLimit)
+
+	_, err = LoadRules(filepath.Join(t.TempDir(), "missing.json"))
+	assert.Error(t, err)
+}
+
+func TestTwentyFiveCardDeckHasJoker(t *testing.T) {
+	rules := DefaultRules()
+	rules.DeckSize = 25
+	jokers := 0
+	for _, card := range rules.NewDeck().Cards {
+		if card.IsJoker() {
+			jokers++
+		}
+	}
+	assert.Equal(t, 1, jokers)
+}
+
+func TestJokerBeatsRightBower(t *testing.T) {
+	joker := NewJoker()
+	right := NewCard(11, Hearts)
+	assert.True(t, joker.Beats(right, Hearts, Hearts))
+	assert.False(t, right.Beats(joker, Hearts, Hearts))
+	assert.Equal(t, Hearts, joker.EffectiveSuit(Hearts))
+}
+
+func TestGameUsesRulesForEveryRound(t *testing.T) {
+	rules := DefaultRules()
+	rules.DeckSize = 32
+	rules.ScoreLimit = 5
+	game := CreateEuchreGameWithRules(CreatePlayers(), rules)
+	game.NewRound()
+	round := game.Rounds[len(game.Rounds)-1]
+	assert.Equal(t, 32-cardsToDeal*4, len(round.Deck.Cards))
+	assert.Equal(t, 5, round.rules().ScoreLimit)
+
+	game.Players[0].Team.Score = 5
+	assert.True(t, game.SomeoneWon())
+}
+
+func TestLonerPointsFromRules(t *testing.T) {
+	round := createScoredRound(0, true, [4]int{5, 0, 0, 0})
+	rules := DefaultRules()
+	rules.LonerPoints = 5
+	round.Rules = &rules
+	assert.Equal(t, 5, round.ScoreHand().Points)
+}
+
+func TestFarmersHandExchange(t *testing.T) {
+	rules := DefaultRules()
+	rules.FarmersHand = true
+	engine := NewEngine(CreateEuchreGameWithRules(CreatePlayers(), rules))
+	engine.NewGame(false)
+	round := engine.Round
+	seat := round.ActivePlayer
+	player := round.Players[seat]
+
+	player.InitCardMap()
+	farmer := []*Card{NewCard(9, Spades), NewCard(10, Spades), NewCard(9, Hearts), NewCard(10, Hearts), NewCard(9, Clubs)}
+	for _, card := range farmer {
+		player.CardMap.AddToHand(card)
+	}
+	round.Deck.Cards = []*Card{NewCard(13, Diamonds), NewCard(1, Spades), NewCard(11, Hearts), NewCard(1, Clubs)}
+	round.UpCard().TurnFaceUp()
+	assert.True(t, round.HasFarmersHand(seat))
+
+	assert.NoError(t, engine.FarmersExchange(seat, farmer[:3]))
+	assert.True(t, player.CardMap.HasInHand(NewCard(1, Spades)))
+	assert.False(t, player.CardMap.HasInHand(farmer[0]))
+	assert.Len(t, player.CardMap.ToSlice(), 5)
+	assert.Len(t, round.Deck.Cards, 4)
+	assert.Equal(t, King, round.UpCard().Rank, "Expected the up card to stay put")
+	assert.Equal(t, Diamonds, round.UpCard().Suit)
+	assert.False(t, round.HasFarmersHand(seat), "Expected only one exchange per hand")
+}
+
+func TestNoTrumpOnlyWhenAllowed(t *testing.T) {
+	for _, allowed := range []bool{false, true} {
+		rules := DefaultRules()
+		rules.NoTrump = allowed
+		engine := NewEngine(CreateEuchreGameWithRules(CreatePlayers(), rules))
+		engine.NewGame(false)
+		for i := 0; i < 4; i++ {
+			assert.NoError(t, engine.Bid(engine.Round.ActivePlayer, Pass, Spades))
+		}
+		err := engine.Bid(engine.Round.ActivePlayer, OrderUp, NoTrump)
+		if allowed {
+			assert.NoError(t, err)
+			assert.Equal(t, NoTrump, engine.Round.Trump)
+		} else {
+			assert.ErrorIs(t, err, ErrInvalidSuit)
+		}
+	}
+}
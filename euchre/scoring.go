@@ -0,0 +1,162 @@
+package euchre
+
+import "fmt"
+
+const tricksPerHand = 5
+
+// HandResult is the outcome of a hand once all five tricks have been played.
+type HandResult struct {
+	Caller         *Player
+	Makers         *Team
+	Defenders      *Team
+	MakerTricks    int
+	DefenderTricks int
+	Alone          bool
+	DefendedAlone  bool
+	March          bool
+	Euchred        bool
+	Points         int
+	Scorer         *Team   // the team credited with Points
+	RenegedBy      *Player // set when the result is a renege penalty
+}
+
+func (result *HandResult) Describe() string {
+	switch {
+	case result.RenegedBy != nil:
+		return fmt.Sprintf("%s reneged, %s score %d", result.RenegedBy.Name, result.Scorer.Name, result.Points)
+	case result.Euchred && result.DefendedAlone:
+		return fmt.Sprintf("%s was euchred by a lone defender for %d", result.Caller.Name, result.Points)
+	case result.Euchred:
+		return fmt.Sprintf("%s was euchred, defenders score %d", result.Caller.Name, result.Points)
+	case result.March && result.Alone:
+		return fmt.Sprintf("%s took all five alone for %d", result.Caller.Name, result.Points)
+	case result.March:
+		return fmt.Sprintf("%s's team marched for %d", result.Caller.Name, result.Points)
+	default:
+		return fmt.Sprintf("%s's team made it for %d", result.Caller.Name, result.Points)
+	}
+}
+
+// ResolveTrick works out who took the trick, credits them and hands them the lead.
+// Once the last trick of the hand is in, the hand is scored.
+func (round *Round) ResolveTrick(trick []*Card) int {
+	round.teams()
+	winner := round.DetermineTrickWinner(trick, round.Lead)
+	round.Players[winner].TricksWon++
+	round.Players[winner].Team.TricksWon++
+	round.TricksPlayed++
+	round.Lead = winner
+	round.ActivePlayer = winner
+
+	if round.HandComplete() {
+		round.ScoreHand()
+	}
+	return winner
+}
+
+func (round *Round) HandComplete() bool {
+	return round.TricksPlayed >= tricksPerHand
+}
+
+// ScoreHand converts the tricks taken into points and credits both partners of the scoring team.
+// Scoring a hand twice is a no-op, the original result is returned.
+func (round *Round) ScoreHand() *HandResult {
+	if round.Result != nil {
+		return round.Result
+	}
+	if round.Caller == nil {
+		return nil
+	}
+
+	makers := round.Caller.Team
+	var defenders *Team
+	for _, team := range round.teams() {
+		if team != makers {
+			defenders = team
+		}
+	}
+
+	result := &HandResult{
+		Caller:         round.Caller,
+		Makers:         makers,
+		Defenders:      defenders,
+		MakerTricks:    makers.TricksWon,
+		DefenderTricks: defenders.TricksWon,
+		Alone:          round.Alone,
+		DefendedAlone:  round.Defender != nil,
+	}
+
+	switch {
+	case result.MakerTricks < 3:
+		// Euchre
+		result.Euchred = true
+		result.Points = 2
+		if round.Defender != nil {
+			result.Points = round.rules().DefendPoints
+		}
+		result.Scorer = defenders
+	case result.MakerTricks == tricksPerHand:
+		result.March = true
+		result.Points = 2
+		if round.Alone {
+			result.Points = round.rules().LonerPoints
+		}
+		result.Scorer = makers
+	default:
+		result.Points = 1
+		result.Scorer = makers
+	}
+
+	result.Scorer.AddPoints(result.Points)
+	makers.History = append(makers.History, result)
+	defenders.History = append(defenders.History, result)
+	round.Result = result
+	return result
+}
+
+// ApplyRenegePenalty replaces the hand's result once a renege has been proven.
+// The offending team scores nothing and the other team scores two, or the loner or defend alone points
+// if they went alone.
+func (round *Round) ApplyRenegePenalty(offender *Player) *HandResult {
+	original := round.ScoreHand()
+	if original == nil {
+		return nil
+	}
+	original.Scorer.AddPoints(-original.Points)
+
+	penalty := *original
+	penalty.RenegedBy = offender
+	penalty.Euchred = false
+	penalty.March = false
+	penalty.Points = 2
+	if offender.Team == original.Makers {
+		penalty.Scorer = original.Defenders
+		if round.Defender != nil {
+			penalty.Points = round.rules().DefendPoints
+		}
+	} else {
+		penalty.Scorer = original.Makers
+		if round.Alone {
+			penalty.Points = round.rules().LonerPoints
+		}
+	}
+	penalty.Scorer.AddPoints(penalty.Points)
+
+	for _, team := range []*Team{original.Makers, original.Defenders} {
+		for i, result := range team.History {
+			if result == original {
+				team.History[i] = &penalty
+			}
+		}
+	}
+	round.Result = &penalty
+	return round.Result
+}
+
+// teams returns the partnerships at the table, forming them if the round was set up without a game
+func (round *Round) teams() []*Team {
+	if round.Teams == nil {
+		round.Teams = FormTeams(round.Players)
+	}
+	return round.Teams
+}
@@ -0,0 +1,107 @@
+package euchre
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func createScoredRound(callerSeat int, alone bool, tricks [4]int) *Round {
+	players := CreatePlayers()
+	teams := FormTeams(players)
+	for i, p := range players {
+		p.TricksWon = tricks[i]
+		p.Team.TricksWon += tricks[i]
+	}
+	return &Round{
+		Players:      players,
+		Teams:        teams,
+		Caller:       players[callerSeat],
+		Alone:        alone,
+		TricksPlayed: tricksPerHand,
+	}
+}
+
+func TestScoreHandMakersTakeThree(t *testing.T) {
+	round := createScoredRound(0, false, [4]int{2, 1, 1, 1})
+	result := round.ScoreHand()
+	assert.Equal(t, 1, result.Points)
+	assert.Equal(t, 3, result.MakerTricks)
+	assert.False(t, result.Euchred)
+	assert.Equal(t, 1, round.Players[0].Team.Score)
+	assert.Same(t, round.Players[0].Team, round.Players[2].Team, "Expected partners to share a team")
+	assert.Equal(t, result.Makers, result.Scorer)
+	assert.Equal(t, 0, round.Players[1].Team.Score)
+	assert.Equal(t, 0, round.Players[3].Team.Score)
+}
+
+func TestScoreHandMarch(t *testing.T) {
+	round := createScoredRound(1, false, [4]int{0, 3, 0, 2})
+	result := round.ScoreHand()
+	assert.True(t, result.March)
+	assert.Equal(t, 2, round.Players[1].Team.Score)
+	assert.Equal(t, 2, round.Players[3].Team.Score)
+}
+
+func TestScoreHandLonerMarch(t *testing.T) {
+	round := createScoredRound(2, true, [4]int{0, 0, 5, 0})
+	result := round.ScoreHand()
+	assert.True(t, result.March)
+	assert.Equal(t, 4, result.Points)
+	assert.Equal(t, 4, round.Players[0].Team.Score)
+	assert.Equal(t, 4, round.Players[2].Team.Score)
+}
+
+func TestScoreHandLonerTakesThree(t *testing.T) {
+	round := createScoredRound(2, true, [4]int{0, 1, 3, 1})
+	result := round.ScoreHand()
+	assert.Equal(t, 1, result.Points)
+}
+
+func TestScoreHandEuchre(t *testing.T) {
+	round := createScoredRound(0, false, [4]int{1, 2, 1, 1})
+	result := round.ScoreHand()
+	assert.True(t, result.Euchred)
+	assert.Equal(t, 2, result.Points)
+	assert.Equal(t, 0, round.Players[0].Team.Score)
+	assert.Equal(t, 2, round.Players[1].Team.Score)
+	assert.Equal(t, 2, round.Players[3].Team.Score)
+}
+
+func TestScoreHandOnlyOnce(t *testing.T) {
+	round := createScoredRound(0, false, [4]int{2, 1, 1, 1})
+	round.ScoreHand()
+	round.ScoreHand()
+	assert.Equal(t, 1, round.Players[0].Team.Score)
+}
+
+func TestResolveTrickScoresAfterFifthTrick(t *testing.T) {
+	players := CreatePlayers()
+	round := &Round{Players: players, Caller: players[0], Trump: Spades}
+	trick := []*Card{NewCard(11, Spades), NewCard(9, Hearts), NewCard(10, Hearts), NewCard(12, Hearts)}
+	for i := 0; i < tricksPerHand; i++ {
+		assert.Nil(t, round.Result)
+		winner := round.ResolveTrick(trick)
+		assert.Equal(t, 0, winner)
+		assert.Equal(t, 0, round.Lead, "Expected the winner to lead the next trick")
+	}
+	assert.True(t, round.HandComplete())
+	assert.NotNil(t, round.Result)
+	assert.True(t, round.Result.March)
+	assert.Equal(t, 2, players[0].Team.Score)
+	assert.Equal(t, 2, players[2].Team.Score)
+}
+
+func TestResolveTrickEuchresTheCallerUnderAnAce(t *testing.T) {
+	players := CreatePlayers()
+	round := &Round{Players: players, Caller: players[0], Trump: Spades}
+	trick := []*Card{NewCard(9, Hearts), NewCard(1, Hearts), NewCard(10, Hearts), NewCard(13, Hearts)}
+	for i := 0; i < tricksPerHand; i++ {
+		assert.Equal(t, 1, round.ResolveTrick(trick))
+	}
+	if assert.NotNil(t, round.Result) {
+		assert.True(t, round.Result.Euchred)
+	}
+	assert.Equal(t, 0, players[0].Team.Score)
+	assert.Equal(t, 2, players[1].Team.Score)
+}
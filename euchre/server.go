@@ -0,0 +1,355 @@
+package euchre
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"sync"
+
+	"golang.org/x/net/websocket"
+)
+
+// Server lets up to four people play one game over TCP or WebSocket. Clients claim seats, the seats nobody
+// claims are played by the computer, and only the server applies the rules.
+//
+// Over TCP every message is a line of JSON. Over WebSocket every message is a JSON text frame.
+
+var (
+	ErrSeatTaken      = errors.New("that seat is taken")
+	ErrNotSeated      = errors.New("claim a seat first")
+	ErrAlreadySeated  = errors.New("already seated")
+	ErrNotStarted     = errors.New("the game hasn't started")
+	ErrUnknownMessage = errors.New("unknown message")
+)
+
+// ClientMessage is a request from a client. Only the fields relevant to the Type are set.
+type ClientMessage struct {
+	Type   string  `json:"type"` // claim, start, bid, farmersHand, defendAlone, discard, play, nextHand, renege
+	Seat   int     `json:"seat"` // the seat to claim, -1 for any free seat
+	Name   string  `json:"name,omitempty"`
+	Call   Call    `json:"call,omitempty"`
+	Suit   Suit    `json:"suit,omitempty"`
+	Card   *Card   `json:"card,omitempty"`
+	Cards  []*Card `json:"cards,omitempty"`
+	Defend bool    `json:"defend,omitempty"`
+}
+
+// ServerMessage is sent to a client: seated, state, event, error or gameOver
+type ServerMessage struct {
+	Type  string        `json:"type"`
+	Seat  int           `json:"seat"`
+	Error string        `json:"error,omitempty"`
+	Event *EventMessage `json:"event,omitempty"`
+	State *PlayerView   `json:"state,omitempty"`
+}
+
+// EventMessage is an engine event as one seat is allowed to see it
+type EventMessage struct {
+	Type   EventType `json:"type"`
+	Seat   int       `json:"seat"`
+	Call   Call      `json:"call"`
+	Suit   Suit      `json:"suit"`
+	Card   *Card     `json:"card,omitempty"`
+	Trick  [4]*Card  `json:"trick"`
+	Result string    `json:"result,omitempty"`
+}
+
+// messageConn is a client connection, whichever transport it came in on
+type messageConn interface {
+	Send(message ServerMessage) error
+	Receive(message *ClientMessage) error
+	Close() error
+}
+
+type client struct {
+	conn messageConn
+	seat int
+	send chan ServerMessage
+}
+
+type Server struct {
+	Engine  *Engine
+	mu      sync.Mutex
+	seats   []*client
+	clients []*client
+	started bool
+	done    chan struct{}
+}
+
+// NewServer hosts the game with every seat played by the computer until someone claims it
+func NewServer(game *Game) *Server {
+	for _, player := range game.Players {
+		player.ComputerPlayer = true
+	}
+	server := &Server{
+		Engine: NewEngine(game),
+		seats:  make([]*client, len(game.Players)),
+		done:   make(chan struct{}),
+	}
+	server.Engine.Subscribe(server.onEngineEvent)
+	return server
+}
+
+// Done is closed once the game is over
+func (s *Server) Done() <-chan struct{} {
+	return s.done
+}
+
+// ServeTCP accepts clients until the listener is closed
+func (s *Server) ServeTCP(listener net.Listener) error {
+	for {
+		conn, err := listener.Accept()
+		if err != nil {
+			return err
+		}
+		go s.handle(newJSONConn(conn))
+	}
+}
+
+func (s *Server) WebSocketHandler() http.Handler {
+	return websocket.Handler(func(ws *websocket.Conn) {
+		s.handle(wsConn{ws})
+	})
+}
+
+func (s *Server) handle(conn messageConn) {
+	c := &client{conn: conn, seat: -1, send: make(chan ServerMessage, 256)}
+	go func() {
+		for message := range c.send {
+			if conn.Send(message) != nil {
+				conn.Close()
+			}
+		}
+	}()
+	s.mu.Lock()
+	s.clients = append(s.clients, c)
+	s.mu.Unlock()
+	defer s.disconnect(c)
+
+	for {
+		var message ClientMessage
+		if err := conn.Receive(&message); err != nil {
+			return
+		}
+		s.mu.Lock()
+		if err := s.apply(c, message); err != nil {
+			c.deliver(ServerMessage{Type: "error", Seat: c.seat, Error: err.Error()})
+		}
+		s.mu.Unlock()
+	}
+}
+
+// deliver queues a message, a client that can't keep up is dropped
+func (c *client) deliver(message ServerMessage) {
+	select {
+	case c.send <- message:
+	default:
+		c.conn.Close()
+	}
+}
+
+func (s *Server) apply(c *client, message ClientMessage) error {
+	if message.Type == "claim" {
+		return s.claim(c, message.Seat, message.Name)
+	}
+	if c.seat < 0 {
+		return ErrNotSeated
+	}
+	if message.Type == "start" {
+		if !s.started {
+			s.start()
+		}
+		return nil
+	}
+	if !s.started {
+		return ErrNotStarted
+	}
+
+	e := s.Engine
+	var err error
+	switch message.Type {
+	case "bid":
+		err = e.Bid(c.seat, message.Call, message.Suit)
+	case "farmersHand":
+		err = e.FarmersExchange(c.seat, message.Cards)
+	case "defendAlone":
+		err = e.DefendAlone(c.seat, message.Defend)
+	case "discard":
+		err = e.Discard(c.seat, message.Card)
+	case "play":
+		err = e.Play(c.seat, message.Card)
+	case "nextHand":
+		err = e.NextHand()
+	case "renege":
+		_, err = e.CallRenege(c.seat)
+	default:
+		err = fmt.Errorf("%w %q", ErrUnknownMessage, message.Type)
+	}
+	if err != nil {
+		return err
+	}
+	s.advance()
+	return nil
+}
+
+func (s *Server) claim(c *client, seat int, name string) error {
+	if c.seat >= 0 {
+		return ErrAlreadySeated
+	}
+	if seat < 0 {
+		for free, taken := range s.seats {
+			if taken == nil {
+				seat = free
+				break
+			}
+		}
+	}
+	if seat < 0 || seat >= len(s.seats) || s.seats[seat] != nil {
+		return ErrSeatTaken
+	}
+	s.seats[seat] = c
+	c.seat = seat
+	player := s.Engine.Game.Players[seat]
+	player.ComputerPlayer = false
+	if name != "" {
+		player.Name = name
+	}
+	c.deliver(ServerMessage{Type: "seated", Seat: seat})
+
+	switch {
+	case !s.started && s.seatsFull():
+		s.start()
+	case s.started:
+		s.broadcastState()
+	}
+	return nil
+}
+
+func (s *Server) empty() bool {
+	for _, c := range s.seats {
+		if c != nil {
+			return false
+		}
+	}
+	return true
+}
+
+func (s *Server) seatsFull() bool {
+	for _, c := range s.seats {
+		if c == nil {
+			return false
+		}
+	}
+	return true
+}
+
+// start deals the first hand, the computer plays every seat nobody has claimed
+func (s *Server) start() {
+	s.started = true
+	game := s.Engine.Game
+	game.Teams = FormTeams(game.Players) // the team names use the claimed names
+	s.Engine.NewGame(false)
+	s.advance()
+}
+
+// advance lets the computer players act, then tells everyone where the game stands.
+// With nobody seated the computer deals the next hands too.
+func (s *Server) advance() {
+	err := s.Engine.RunBots()
+	for err == nil && s.Engine.Phase == PhaseHandOver && s.empty() {
+		if err = s.Engine.NextHand(); err == nil {
+			err = s.Engine.RunBots()
+		}
+	}
+	if err != nil {
+		for _, c := range s.clients {
+			c.deliver(ServerMessage{Type: "error", Seat: c.seat, Error: err.Error()})
+		}
+	}
+	s.broadcastState()
+	if s.Engine.Phase == PhaseGameOver {
+		for _, c := range s.clients {
+			c.deliver(ServerMessage{Type: "gameOver", Seat: c.seat})
+		}
+		select {
+		case <-s.done:
+		default:
+			close(s.done)
+		}
+	}
+}
+
+// disconnect hands the seat back to the computer
+func (s *Server) disconnect(c *client) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for i, other := range s.clients {
+		if other == c {
+			s.clients = append(s.clients[:i], s.clients[i+1:]...)
+			break
+		}
+	}
+	close(c.send)
+	c.conn.Close()
+	if c.seat < 0 {
+		return
+	}
+	s.seats[c.seat] = nil
+	s.Engine.Game.Players[c.seat].ComputerPlayer = true
+	if s.started {
+		s.advance()
+	}
+}
+
+func (s *Server) broadcastState() {
+	for _, c := range s.clients {
+		c.deliver(ServerMessage{Type: "state", Seat: c.seat, State: s.Engine.View(c.seat)})
+	}
+}
+
+func (s *Server) onEngineEvent(event Event) {
+	for _, c := range s.clients {
+		message := &EventMessage{Type: event.Type, Seat: event.Seat, Call: event.Call, Suit: event.Suit,
+			Card: event.Card, Trick: event.Trick}
+		if event.Type == EventDiscard && event.Seat != c.seat {
+			message.Card = nil // the discard goes face down
+		}
+		if event.Result != nil {
+			message.Result = event.Result.Describe()
+		}
+		c.deliver(ServerMessage{Type: "event", Seat: c.seat, Event: message})
+	}
+}
+
+// jsonConn carries one JSON message per line over a TCP connection
+type jsonConn struct {
+	net.Conn
+	encoder *json.Encoder
+	decoder *json.Decoder
+}
+
+func newJSONConn(conn net.Conn) *jsonConn {
+	return &jsonConn{Conn: conn, encoder: json.NewEncoder(conn), decoder: json.NewDecoder(conn)}
+}
+
+func (conn *jsonConn) Send(message ServerMessage) error {
+	return conn.encoder.Encode(message)
+}
+
+func (conn *jsonConn) Receive(message *ClientMessage) error {
+	return conn.decoder.Decode(message)
+}
+
+type wsConn struct {
+	*websocket.Conn
+}
+
+func (conn wsConn) Send(message ServerMessage) error {
+	return websocket.JSON.Send(conn.Conn, message)
+}
+
+func (conn wsConn) Receive(message *ClientMessage) error {
+	return websocket.JSON.Receive(conn.Conn, message)
+}
@@ -0,0 +1,286 @@
+package euchre
+
+import (
+	"encoding/json"
+	"net"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/net/websocket"
+)
+
+// scriptedClient plays whatever it is dealt: it orders up, discards its first card and plays its first legal card
+type scriptedClient struct {
+	send    func(ClientMessage) error
+	receive func(*ServerMessage) error
+	seat    int
+	errors  []string
+	seen    []ServerMessage // the states and events, in the order they came
+	over    bool
+}
+
+func dialTCP(t *testing.T, address string) *scriptedClient {
+	conn, err := net.Dial("tcp", address)
+	assert.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+	encoder, decoder := json.NewEncoder(conn), json.NewDecoder(conn)
+	return &scriptedClient{
+		seat:    -1,
+		send:    func(message ClientMessage) error { return encoder.Encode(message) },
+		receive: func(message *ServerMessage) error { return decoder.Decode(message) },
+	}
+}
+
+func dialWebSocket(t *testing.T, url string) *scriptedClient {
+	ws, err := websocket.Dial(url, "", "http://localhost/")
+	assert.NoError(t, err)
+	t.Cleanup(func() { ws.Close() })
+	return &scriptedClient{
+		seat:    -1,
+		send:    func(message ClientMessage) error { return websocket.JSON.Send(ws, message) },
+		receive: func(message *ServerMessage) error { return websocket.JSON.Receive(ws, message) },
+	}
+}
+
+func startTCPServer(t *testing.T, seed int64) (*Server, string) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.NoError(t, err)
+	t.Cleanup(func() { listener.Close() })
+	server := NewServer(CreateSeededGame(CreatePlayers(), DefaultRules(), seed))
+	go server.ServeTCP(listener)
+	return server, listener.Addr().String()
+}
+
+// play answers the server until the game is over or the connection drops
+func (c *scriptedClient) play(finished chan<- *scriptedClient) {
+	defer func() { finished <- c }()
+	for {
+		var message ServerMessage
+		if c.receive(&message) != nil {
+			return
+		}
+		switch message.Type {
+		case "seated":
+			c.seat = message.Seat
+		case "error":
+			c.errors = append(c.errors, message.Error)
+		case "gameOver":
+			c.over = true
+			return
+		case "event":
+			c.seen = append(c.seen, message)
+		case "state":
+			c.seen = append(c.seen, message)
+			if c.respond(message.State) != nil {
+				return
+			}
+		}
+	}
+}
+
+func (c *scriptedClient) respond(state *PlayerView) error {
+	if state.Phase == PhaseHandOver {
+		// The first seat with a person in it deals the next hand
+		for seat, computer := range state.Computer {
+			if !computer {
+				if seat == c.seat {
+					return c.send(ClientMessage{Type: "nextHand"})
+				}
+				break
+			}
+		}
+	}
+	if !state.YourTurn {
+		return nil
+	}
+	switch state.Phase {
+	case PhaseBidding:
+		suit := Hearts
+		if state.FirstBiddingRound {
+			suit = state.UpCard.Suit
+		} else if state.UpCard.Suit == Hearts {
+			suit = Spades
+		}
+		return c.send(ClientMessage{Type: "bid", Call: OrderUp, Suit: suit})
+	case PhaseDefendAlone:
+		return c.send(ClientMessage{Type: "defendAlone"})
+	case PhaseDiscard:
+		return c.send(ClientMessage{Type: "discard", Card: state.Hand[0]})
+	case PhasePlay:
+		return c.send(ClientMessage{Type: "play", Card: state.LegalPlays[0]})
+	}
+	return nil
+}
+
+// hiddenAtEachEvent records the cards every seat has no right to know as each engine event goes out.
+// The server sends its messages from the same engine state, every event and then the state once the moves settle.
+func hiddenAtEachEvent(server *Server) func() [][][]*Card {
+	var hidden [][][]*Card
+	server.mu.Lock()
+	defer server.mu.Unlock()
+	server.Engine.Subscribe(func(Event) {
+		var seats [][]*Card
+		for seat := range server.Engine.Game.Players {
+			seats = append(seats, hiddenFrom(server.Engine, seat))
+		}
+		hidden = append(hidden, seats)
+	})
+	return func() [][][]*Card {
+		server.mu.Lock()
+		defer server.mu.Unlock()
+		return hidden
+	}
+}
+
+// assertNoLeaksSent checks every card the client was sent against what was hidden from its seat at the time
+func assertNoLeaksSent(t *testing.T, c *scriptedClient, hidden [][][]*Card) {
+	events := 0
+	for _, message := range c.seen {
+		var cards []*Card
+		if message.Type == "event" {
+			events++
+			event := message.Event
+			// The dealer knows what they discarded
+			if event.Card != nil && !(event.Type == EventDiscard && event.Seat == c.seat) {
+				cards = append(cards, event.Card)
+			}
+			for _, card := range event.Trick {
+				if card != nil {
+					cards = append(cards, card)
+				}
+			}
+		} else {
+			cards = visibleCards(t, message.State)
+		}
+		if !assert.NotZero(t, events, "Expected an event before the first state") {
+			return
+		}
+		for _, card := range cards {
+			assert.False(t, ContainsCard(hidden[events-1][c.seat], card), "Seat %d was sent the %s in a message of type %s",
+				c.seat, cardName(card), message.Type)
+		}
+	}
+}
+
+func waitForGameOver(t *testing.T, server *Server, finished <-chan *scriptedClient, clients int) []*scriptedClient {
+	select {
+	case <-server.Done():
+	case <-time.After(30 * time.Second):
+		t.Fatal("Expected the game to finish")
+	}
+	var done []*scriptedClient
+	for i := 0; i < clients; i++ {
+		select {
+		case c := <-finished:
+			done = append(done, c)
+		case <-time.After(5 * time.Second):
+			t.Fatal("Expected every client to hear the game is over")
+		}
+	}
+	return done
+}
+
+func TestServerPlaysFourScriptedClients(t *testing.T) {
+	server, address := startTCPServer(t, 21)
+	hidden := hiddenAtEachEvent(server)
+	finished := make(chan *scriptedClient, 4)
+	for seat := 0; seat < 4; seat++ {
+		c := dialTCP(t, address)
+		assert.NoError(t, c.send(ClientMessage{Type: "claim", Seat: seat, Name: []string{"Ann", "Bob", "Cy", "Di"}[seat]}))
+		go c.play(finished)
+	}
+
+	for _, c := range waitForGameOver(t, server, finished, 4) {
+		assert.True(t, c.over)
+		assert.Empty(t, c.errors)
+		assertNoLeaksSent(t, c, hidden())
+	}
+	server.mu.Lock()
+	defer server.mu.Unlock()
+	assert.Equal(t, PhaseGameOver, server.Engine.Phase)
+	for _, player := range server.Engine.Game.Players {
+		assert.False(t, player.ComputerPlayer, "Expected every seat to be played by its client")
+	}
+	assert.Equal(t, "Ann & Cy", server.Engine.Game.Teams[0].Name)
+}
+
+func TestServerFillsEmptySeatsWithBots(t *testing.T) {
+	server, address := startTCPServer(t, 3)
+	hidden := hiddenAtEachEvent(server)
+	c := dialTCP(t, address)
+	assert.NoError(t, c.send(ClientMessage{Type: "claim", Seat: -1}))
+	assert.NoError(t, c.send(ClientMessage{Type: "start"}))
+	finished := make(chan *scriptedClient, 1)
+	go c.play(finished)
+
+	done := waitForGameOver(t, server, finished, 1)
+	assert.Equal(t, 0, done[0].seat)
+	assert.Empty(t, done[0].errors)
+	assertNoLeaksSent(t, done[0], hidden())
+	server.mu.Lock()
+	defer server.mu.Unlock()
+	assert.True(t, server.Engine.Game.Players[1].ComputerPlayer)
+}
+
+func TestServerRejectsTakenSeatsAndUnseatedMoves(t *testing.T) {
+	_, address := startTCPServer(t, 3)
+	first, second := dialTCP(t, address), dialTCP(t, address)
+	assert.NoError(t, first.send(ClientMessage{Type: "claim", Seat: 1}))
+	var message ServerMessage
+	assert.NoError(t, first.receive(&message))
+	assert.Equal(t, "seated", message.Type)
+
+	assert.NoError(t, second.send(ClientMessage{Type: "bid", Call: OrderUp}))
+	assert.NoError(t, second.receive(&message))
+	assert.Equal(t, ErrNotSeated.Error(), message.Error)
+	assert.NoError(t, second.send(ClientMessage{Type: "claim", Seat: 1}))
+	assert.NoError(t, second.receive(&message))
+	assert.Equal(t, ErrSeatTaken.Error(), message.Error)
+	assert.NoError(t, first.send(ClientMessage{Type: "play"}))
+	assert.NoError(t, first.receive(&message))
+	assert.Equal(t, ErrNotStarted.Error(), message.Error)
+}
+
+func TestServerHandsADroppedSeatToTheComputer(t *testing.T) {
+	server, address := startTCPServer(t, 5)
+	conn, err := net.Dial("tcp", address)
+	assert.NoError(t, err)
+	encoder, decoder := json.NewEncoder(conn), json.NewDecoder(conn)
+	assert.NoError(t, encoder.Encode(ClientMessage{Type: "claim", Seat: 2}))
+	assert.NoError(t, encoder.Encode(ClientMessage{Type: "start"}))
+	// Drop out once the game has started, the first state is only sent after the start
+	var message ServerMessage
+	for message.Type != "state" {
+		if !assert.NoError(t, decoder.Decode(&message)) {
+			return
+		}
+	}
+	conn.Close()
+
+	select {
+	case <-server.Done():
+	case <-time.After(30 * time.Second):
+		t.Fatal("Expected the computer to finish the game")
+	}
+}
+
+func TestServerOverWebSocket(t *testing.T) {
+	server := NewServer(CreateSeededGame(CreatePlayers(), DefaultRules(), 9))
+	hidden := hiddenAtEachEvent(server)
+	http := httptest.NewServer(server.WebSocketHandler())
+	defer http.Close()
+
+	c := dialWebSocket(t, "ws"+strings.TrimPrefix(http.URL, "http"))
+	assert.NoError(t, c.send(ClientMessage{Type: "claim", Seat: 3}))
+	assert.NoError(t, c.send(ClientMessage{Type: "start"}))
+	finished := make(chan *scriptedClient, 1)
+	go c.play(finished)
+
+	done := waitForGameOver(t, server, finished, 1)
+	assert.True(t, done[0].over)
+	assert.Empty(t, done[0].errors)
+	assertNoLeaksSent(t, done[0], hidden())
+}
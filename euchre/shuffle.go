@@ -0,0 +1,95 @@
+package euchre
+
+import "math/rand"
+
+// Shuffler puts the cards of a deck into a new order using the given source of randomness
+type Shuffler interface {
+	Shuffle(cards []*Card, rng *rand.Rand)
+}
+
+// FisherYates gives every order of the deck the same chance
+type FisherYates struct{}
+
+func (FisherYates) Shuffle(cards []*Card, rng *rand.Rand) {
+	for i := len(cards) - 1; i > 0; i-- {
+		j := rng.Intn(i + 1)
+		cards[i], cards[j] = cards[j], cards[i]
+	}
+}
+
+// Riffle imitates a person riffling the deck Times times. Each riffle cuts the deck about in half
+// and drops cards from each half in proportion to how many are left in it.
+type Riffle struct {
+	Times int
+}
+
+func (riffle Riffle) Shuffle(cards []*Card, rng *rand.Rand) {
+	for i := 0; i < riffle.Times; i++ {
+		left := append([]*Card{}, cards[:cutPoint(len(cards), rng)]...)
+		right := append([]*Card{}, cards[len(left):]...)
+		for c := range cards {
+			if rng.Intn(len(left)+len(right)) < len(left) {
+				cards[c], left = left[0], left[1:]
+			} else {
+				cards[c], right = right[0], right[1:]
+			}
+		}
+	}
+}
+
+// Cut moves the top of the deck to the bottom, at about the middle
+type Cut struct{}
+
+func (Cut) Shuffle(cards []*Card, rng *rand.Rand) {
+	cut := cutPoint(len(cards), rng)
+	top := append([]*Card{}, cards[:cut]...)
+	copy(cards, cards[cut:])
+	copy(cards[len(cards)-cut:], top)
+}
+
+// cutPoint is where a person would split the deck, each card has an even chance of landing in either half
+func cutPoint(size int, rng *rand.Rand) int {
+	cut := 0
+	for i := 0; i < size; i++ {
+		cut += rng.Intn(2)
+	}
+	return cut
+}
+
+// ShuffleSequence applies several shuffles in turn
+type ShuffleSequence []Shuffler
+
+func (sequence ShuffleSequence) Shuffle(cards []*Card, rng *rand.Rand) {
+	for _, shuffler := range sequence {
+		shuffler.Shuffle(cards, rng)
+	}
+}
+
+// HumanShuffle is seven riffles and a cut, enough to mix a euchre deck the way a careful dealer would
+func HumanShuffle() Shuffler {
+	return ShuffleSequence{Riffle{Times: 7}, Cut{}}
+}
+
+// Stacked puts the given cards on top of the deck in order, leaving the rest below them as they were.
+// The first five cards are dealt to the first seat, the next five to the second and so on, then the up card.
+type Stacked struct {
+	Cards []*Card
+}
+
+func (stacked Stacked) Shuffle(cards []*Card, rng *rand.Rand) {
+	var top, rest []*Card
+	for _, want := range stacked.Cards {
+		for _, card := range cards {
+			if card.Rank == want.Rank && card.Suit == want.Suit {
+				top = append(top, card)
+				break
+			}
+		}
+	}
+	for _, card := range cards {
+		if !ContainsCard(top, card) {
+			rest = append(rest, card)
+		}
+	}
+	copy(cards, append(top, rest...))
+}
@@ -0,0 +1,121 @@
+package euchre
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// chiSquared compares observed counts against an even spread over every cell
+func chiSquared(counts []int, trials int) float64 {
+	expected := float64(trials) / float64(len(counts))
+	total := 0.0
+	for _, count := range counts {
+		diff := float64(count) - expected
+		total += diff * diff / expected
+	}
+	return total
+}
+
+// positionCounts shuffles a euchre deck many times and counts where the top card ends up
+func positionCounts(shuffler Shuffler, trials int) []int {
+	rng := rand.New(rand.NewSource(1))
+	counts := make([]int, 24)
+	for i := 0; i < trials; i++ {
+		deck := DefaultRules().NewDeck()
+		top := deck.Cards[0]
+		deck.ShuffleUsing(shuffler, rng)
+		for position, card := range deck.Cards {
+			if card == top {
+				counts[position]++
+			}
+		}
+	}
+	return counts
+}
+
+// The critical value for 23 degrees of freedom at p = 0.001
+const positionChiSquaredLimit = 49.73
+
+func TestFisherYatesPermutationsAreEven(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	cards := []*Card{NewCard(9, Hearts), NewCard(10, Hearts), NewCard(11, Hearts), NewCard(12, Hearts)}
+	trials := 24000
+	seen := map[[4]int]int{}
+	for i := 0; i < trials; i++ {
+		shuffled := append([]*Card{}, cards...)
+		FisherYates{}.Shuffle(shuffled, rng)
+		var order [4]int
+		for i, card := range shuffled {
+			order[i] = card.Rank
+		}
+		seen[order]++
+	}
+	assert.Len(t, seen, 24, "Expected every order of four cards")
+	var counts []int
+	for _, count := range seen {
+		counts = append(counts, count)
+	}
+	assert.Less(t, chiSquared(counts, trials), positionChiSquaredLimit)
+}
+
+func TestFisherYatesPositionsAreEven(t *testing.T) {
+	assert.Less(t, chiSquared(positionCounts(FisherYates{}, 24000), 24000), positionChiSquaredLimit)
+}
+
+func TestHumanShufflePositionsAreEven(t *testing.T) {
+	assert.Less(t, chiSquared(positionCounts(HumanShuffle(), 24000), 24000), positionChiSquaredLimit)
+}
+
+func TestSingleRiffleIsNotEnough(t *testing.T) {
+	// One riffle keeps the top card near the top, the test above would catch a shuffle this weak
+	assert.Greater(t, chiSquared(positionCounts(Riffle{Times: 1}, 24000), 24000), positionChiSquaredLimit)
+}
+
+func TestRiffleKeepsEveryCard(t *testing.T) {
+	deck := DefaultRules().NewDeck()
+	deck.ShuffleUsing(Riffle{Times: 3}, rand.New(rand.NewSource(1)))
+	assert.ElementsMatch(t, DefaultRules().NewDeck().Cards, deck.Cards)
+}
+
+func TestCutKeepsTheOrderAroundTheDeck(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	cuts := make([]int, 25)
+	for i := 0; i < 1000; i++ {
+		deck := DefaultRules().NewDeck()
+		original := append([]*Card{}, deck.Cards...)
+		deck.ShuffleUsing(Cut{}, rng)
+
+		cut := 0
+		for original[cut] != deck.Cards[0] {
+			cut++
+		}
+		for position, card := range deck.Cards {
+			assert.Same(t, original[(position+cut)%24], card)
+		}
+		cuts[cut]++
+	}
+	assert.Zero(t, cuts[0]+cuts[1]+cuts[23], "Expected cuts near the middle of the deck")
+	assert.Greater(t, cuts[12], cuts[6])
+}
+
+func TestStackedDeckDealsChosenHands(t *testing.T) {
+	players := CreatePlayers()
+	hand := []*Card{NewCard(11, Hearts), NewCard(11, Diamonds), NewCard(1, Hearts), NewCard(13, Hearts), NewCard(12, Hearts)}
+	round := &Round{Players: players, Shuffler: Stacked{Cards: append(hand, NewCard(9, Spades))}}
+	for _, p := range players {
+		p.InitCardMap()
+	}
+	round.Begin()
+	assert.ElementsMatch(t, hand, players[0].CardMap.ToSlice())
+	assert.True(t, players[1].CardMap.HasInHand(NewCard(9, Spades)))
+	assert.True(t, players[1].CardMap.HasInHand(NewCard(1, Spades)), "Expected the rest of the deck in order")
+}
+
+func TestStackedDeckUsedBySeededGame(t *testing.T) {
+	game := CreateSeededGame(CreatePlayers(), DefaultRules(), 1)
+	game.Shuffler = Stacked{Cards: []*Card{NewCard(1, Clubs)}}
+	game.NewGame(false)
+	assert.True(t, game.Players[0].CardMap.HasInHand(NewCard(1, Clubs)))
+}
@@ -0,0 +1,248 @@
+package euchre
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"runtime"
+	"sort"
+	"strings"
+	"sync"
+	"text/tabwriter"
+)
+
+// A Simulation plays many computer games at once, one goroutine per worker. Every game is seeded on its own,
+// game i from Seed+i, so each has its own deck and the totals are the same however many workers there are.
+type Simulation struct {
+	Games    int
+	Workers  int // runtime.NumCPU() when zero
+	Seed     int64
+	Strategy string // every seat plays it
+	Rules    Rules
+
+	NewStrategy func(name string) (Strategy, error) // makes every seat's strategy, the registry's NewStrategy when nil
+}
+
+// SimulationStats add up the bidding and the results of every hand in a simulation
+type SimulationStats struct {
+	Games    int
+	Hands    int // hands played out, not counting the ones thrown in
+	ThrownIn int
+	Calls    [4]int // by seat from the dealer, FirstSeat to DealerSeat
+	Made     int
+	Euchred  int
+	Marches  int
+	Alone    int
+	Scores   map[int]*ScoreTally // by the caller's GetWScore in the suit called, when they called
+}
+
+// A ScoreTally is how often a hand with some GetWScore called trump, and how often it made it
+type ScoreTally struct {
+	Calls int
+	Made  int
+}
+
+func NewSimulationStats() *SimulationStats {
+	return &SimulationStats{Scores: map[int]*ScoreTally{}}
+}
+
+func (sim Simulation) Run() (*SimulationStats, error) {
+	workers := sim.Workers
+	if workers <= 0 {
+		workers = runtime.NumCPU()
+	}
+	games := make(chan int)
+	results := make([]*SimulationStats, workers)
+	errs := make([]error, workers)
+	var wg sync.WaitGroup
+	for worker := range results {
+		results[worker] = NewSimulationStats()
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for game := range games {
+				if errs[worker] == nil {
+					errs[worker] = sim.playGame(results[worker], sim.Seed+int64(game))
+				}
+			}
+		}()
+	}
+	for game := 0; game < sim.Games; game++ {
+		games <- game
+	}
+	close(games)
+	wg.Wait()
+
+	if err := errors.Join(errs...); err != nil {
+		return nil, err
+	}
+	stats := NewSimulationStats()
+	for _, result := range results {
+		stats.Add(result)
+	}
+	return stats, nil
+}
+
+func (sim Simulation) playGame(stats *SimulationStats, seed int64) error {
+	newStrategy := sim.NewStrategy
+	if newStrategy == nil {
+		newStrategy = NewStrategy
+	}
+	players := make([]*Player, 4)
+	for seat := range players {
+		strategy, err := newStrategy(sim.Strategy)
+		if err != nil {
+			return err
+		}
+		players[seat] = &Player{Name: fmt.Sprintf("Seat %d", seat), ComputerPlayer: true, IsPlaying: true, Strategy: strategy}
+	}
+	game := CreateSeededGame(players, sim.Rules, seed)
+	engine := NewEngine(game)
+	var callScore int
+	engine.Subscribe(func(event Event) {
+		round := engine.Round
+		switch event.Type {
+		case EventBid:
+			if event.Call == Pass {
+				return
+			}
+			stats.Calls[SeatFromDealer(event.Seat, round.Dealer)]++
+			if event.Call == Alone {
+				stats.Alone++
+			}
+			// The dealer who was ordered up has the up card in hand already, score the hand that was dealt
+			hand := round.Players[event.Seat].CardMap
+			if bid := round.Bids[len(round.Bids)-1]; bid.FirstRound && event.Seat == round.Dealer && round.TurnedUp != nil {
+				hand.RemoveFromHand(*round.TurnedUp)
+			}
+			callScore = hand.GetWScore(event.Suit)
+		case EventThrowIn:
+			stats.ThrownIn++
+		case EventHandScored:
+			result := event.Result
+			if result == nil {
+				return
+			}
+			stats.Hands++
+			tally := stats.Scores[callScore]
+			if tally == nil {
+				tally = &ScoreTally{}
+				stats.Scores[callScore] = tally
+			}
+			tally.Calls++
+			if result.Euchred {
+				stats.Euchred++
+				return
+			}
+			stats.Made++
+			tally.Made++
+			if result.March {
+				stats.Marches++
+			}
+		}
+	})
+	engine.NewGame(false)
+	if err := playToTheEnd(engine); err != nil {
+		return fmt.Errorf("game %s: %w", game.ID, err)
+	}
+	stats.Games++
+	return nil
+}
+
+// Add puts another simulation's totals into these
+func (stats *SimulationStats) Add(other *SimulationStats) {
+	stats.Games += other.Games
+	stats.Hands += other.Hands
+	stats.ThrownIn += other.ThrownIn
+	for seat := range stats.Calls {
+		stats.Calls[seat] += other.Calls[seat]
+	}
+	stats.Made += other.Made
+	stats.Euchred += other.Euchred
+	stats.Marches += other.Marches
+	stats.Alone += other.Alone
+	for score, tally := range other.Scores {
+		total := stats.Scores[score]
+		if total == nil {
+			total = &ScoreTally{}
+			stats.Scores[score] = total
+		}
+		total.Calls += tally.Calls
+		total.Made += tally.Made
+	}
+}
+
+func (stats *SimulationStats) Report(w io.Writer) {
+	dealt := stats.Hands + stats.ThrownIn
+	fmt.Fprintf(w, "%d games, %d hands dealt, %.1f%% thrown in\n", stats.Games, dealt, 100*ratio(stats.ThrownIn, dealt))
+	fmt.Fprintf(w, "Made %.1f%%, euchred %.1f%%, marched %.1f%% of the hands played, alone in %.1f%%\n\n",
+		100*ratio(stats.Made, stats.Hands), 100*ratio(stats.Euchred, stats.Hands),
+		100*ratio(stats.Marches, stats.Hands), 100*ratio(stats.Alone, stats.Hands))
+
+	table := tabwriter.NewWriter(w, 0, 0, 2, ' ', tabwriter.AlignRight)
+	fmt.Fprintln(table, "Called by\tFirst seat\tSecond seat\tThird seat\tDealer\t")
+	fmt.Fprint(table, "of hands dealt\t")
+	for _, calls := range stats.Calls {
+		fmt.Fprintf(table, "%.1f%%\t", 100*ratio(calls, dealt))
+	}
+	fmt.Fprintln(table)
+	table.Flush()
+	fmt.Fprintln(w)
+
+	var scores []int
+	for score := range stats.Scores {
+		scores = append(scores, score)
+	}
+	sort.Ints(scores)
+	table = tabwriter.NewWriter(w, 0, 0, 2, ' ', tabwriter.AlignRight)
+	fmt.Fprintln(table, "Score at call\tCalls\tShare\tMade\t")
+	for _, score := range scores {
+		tally := stats.Scores[score]
+		fmt.Fprintf(table, "%d\t%d\t%.1f%%\t%.1f%%\t\n", score, tally.Calls,
+			100*ratio(tally.Calls, stats.Hands), 100*ratio(tally.Made, tally.Calls))
+	}
+	table.Flush()
+}
+
+// SimulateCommand runs a simulation from the command line: euchre simulate -games 10000
+func SimulateCommand(args []string, out io.Writer) error {
+	flags := flag.NewFlagSet("simulate", flag.ContinueOnError)
+	flags.SetOutput(out)
+	games := flags.Int("games", 1000, "games to play")
+	workers := flags.Int("workers", runtime.NumCPU(), "games played at once")
+	seed := flags.Int64("seed", 1, "seed of the first game, the same seed plays the same games")
+	strategy := flags.String("strategy", "basic", "strategy every seat plays: "+strings.Join(StrategyNames(), ", "))
+	biddingFile := flags.String("bidding", "", "bidding profile for the basic strategy, a JSON file like the one tune writes")
+	if err := flags.Parse(args); errors.Is(err, flag.ErrHelp) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	sim := Simulation{
+		Games:    *games,
+		Workers:  *workers,
+		Seed:     *seed,
+		Strategy: *strategy,
+		Rules:    DefaultRules(),
+	}
+	if *biddingFile != "" {
+		config, err := LoadBiddingConfig(*biddingFile)
+		if err != nil {
+			return err
+		}
+		sim.NewStrategy = func(name string) (Strategy, error) {
+			if name == "basic" {
+				return Basic{Bidding: &config}, nil
+			}
+			return NewStrategy(name)
+		}
+	}
+	stats, err := sim.Run()
+	if err != nil {
+		return err
+	}
+	stats.Report(out)
+	return nil
+}
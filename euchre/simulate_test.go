@@ -0,0 +1,64 @@
+package euchre
+
+import (
+	"bytes"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSimulationDoesNotDependOnWorkers(t *testing.T) {
+	one, err := Simulation{Games: 12, Workers: 1, Seed: 5, Strategy: "basic", Rules: DefaultRules()}.Run()
+	assert.NoError(t, err)
+	many, err := Simulation{Games: 12, Workers: 4, Seed: 5, Strategy: "basic", Rules: DefaultRules()}.Run()
+	assert.NoError(t, err)
+	assert.Equal(t, one, many)
+}
+
+func TestSimulationAddsUp(t *testing.T) {
+	stats, err := Simulation{Games: 20, Workers: 3, Seed: 1, Strategy: "basic", Rules: DefaultRules()}.Run()
+	assert.NoError(t, err)
+	assert.Equal(t, 20, stats.Games)
+	assert.Equal(t, stats.Hands, stats.Made+stats.Euchred)
+	assert.LessOrEqual(t, stats.Marches, stats.Made)
+	assert.Equal(t, stats.Hands, stats.Calls[0]+stats.Calls[1]+stats.Calls[2]+stats.Calls[3])
+
+	calls, made := 0, 0
+	for _, tally := range stats.Scores {
+		calls += tally.Calls
+		made += tally.Made
+	}
+	assert.Equal(t, stats.Hands, calls)
+	assert.Equal(t, stats.Made, made)
+}
+
+func TestSimulationReportsErrors(t *testing.T) {
+	_, err := Simulation{Games: 3, Workers: 2, Strategy: "nobody", Rules: DefaultRules()}.Run()
+	assert.ErrorIs(t, err, ErrUnknownStrategy)
+}
+
+func TestSimulateCommandReports(t *testing.T) {
+	var out bytes.Buffer
+	assert.NoError(t, SimulateCommand([]string{"-games", "4", "-workers", "2"}, &out))
+	assert.Contains(t, out.String(), "4 games")
+	assert.Contains(t, out.String(), "Score at call")
+}
+
+func TestSimulateCommandLoadsTheProfileLocally(t *testing.T) {
+	// Never ordering up or going alone leaves every call to the second round
+	config := DefaultBidding()
+	config.Order = [4]int{100, 100, 100, 100}
+	config.Alone = 100
+	path := filepath.Join(t.TempDir(), "profile.json")
+	assert.NoError(t, SaveBiddingConfig(path, config))
+	var profiled, plain bytes.Buffer
+	assert.NoError(t, SimulateCommand([]string{"-games", "2", "-bidding", path}, &profiled))
+	assert.NoError(t, SimulateCommand([]string{"-games", "2"}, &plain))
+	assert.Contains(t, profiled.String(), "alone in 0.0%")
+	assert.NotEqual(t, plain.String(), profiled.String())
+
+	strategy, err := NewStrategy("basic")
+	assert.NoError(t, err)
+	assert.Nil(t, strategy.(Basic).Bidding, "Expected the registry to keep the default basic")
+}
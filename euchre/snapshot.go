@@ -0,0 +1,311 @@
+package euchre
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+)
+
+// A Snapshot is everything needed to pick a game up where it was left: the players, scores, dealer,
+// the current hand and the trick on the table. The seeded source is restored too, so later deals are
+// the same as they would have been.
+
+const snapshotVersion = 1
+
+type PlayerSnapshot struct {
+	Name      string         `json:"name"`
+	Computer  bool           `json:"computer"`
+	Strategy  string         `json:"strategy,omitempty"`
+	Bidding   *BiddingConfig `json:"bidding,omitempty"` // the basic strategy's profile, when it isn't the default
+	Wins      int            `json:"wins"`
+	Losses    int            `json:"losses"`
+	TricksWon int            `json:"tricksWon"`
+	IsPlaying bool           `json:"isPlaying"`
+	CardMap   CardMap        `json:"cardMap"`
+}
+
+type TeamSnapshot struct {
+	Score     int `json:"score"`
+	TricksWon int `json:"tricksWon"`
+}
+
+// RoundSnapshot refers to players by seat, -1 when there is no one
+type RoundSnapshot struct {
+	Dealer         int          `json:"dealer"`
+	Caller         int          `json:"caller"`
+	Defender       int          `json:"defender"`
+	DefenseOffered int          `json:"defenseOffered"`
+	Trump          Suit         `json:"trump"`
+	Lead           int          `json:"lead"`
+	Alone          bool         `json:"alone"`
+	SelectingTrump bool         `json:"selectingTrump"`
+	ActivePlayer   int          `json:"activePlayer"`
+	TricksPlayed   int          `json:"tricksPlayed"`
+	Kitty          []*Card      `json:"kitty"`
+	Plays          []PlayRecord `json:"plays"`
+	TurnedUp       *Card        `json:"turnedUp,omitempty"`
+	Bids           []BidRecord  `json:"bids"`
+	Farmed         bool         `json:"farmed"`
+	PassedOut      bool         `json:"passedOut"`
+	RenegedBy      int          `json:"renegedBy"`
+}
+
+type Snapshot struct {
+	Version   int              `json:"version"`
+	GameID    string           `json:"gameId"`
+	Seed      int64            `json:"seed"`
+	Draws     uint64           `json:"draws"` // numbers drawn from the seeded source so far
+	Rules     Rules            `json:"rules"`
+	Dealer    int              `json:"dealer"`
+	Players   []PlayerSnapshot `json:"players"`
+	Teams     []TeamSnapshot   `json:"teams"`
+	Phase     Phase            `json:"phase"`
+	Round     *RoundSnapshot   `json:"round,omitempty"`
+	Trick     [4]*Card         `json:"trick"`
+	LastTrick [4]*Card         `json:"lastTrick"`
+	Recording *Recording       `json:"recording,omitempty"`
+}
+
+// Snapshot captures the game as it is now
+func (e *Engine) Snapshot() *Snapshot {
+	game := e.Game
+	snapshot := &Snapshot{
+		Version:   snapshotVersion,
+		GameID:    game.ID,
+		Seed:      game.Seed,
+		Draws:     game.source.draws,
+		Rules:     game.Rules,
+		Dealer:    game.Dealer,
+		Phase:     e.Phase,
+		Trick:     e.Trick,
+		LastTrick: e.LastTrick,
+	}
+	if e.Recording != nil {
+		recording := *e.Recording
+		recording.Actions = append([]Action{}, e.Recording.Actions...)
+		snapshot.Recording = &recording
+	}
+	for _, player := range game.Players {
+		strategy := ""
+		if player.Strategy != nil {
+			strategy = player.Strategy.Name()
+		}
+		var bidding *BiddingConfig
+		if basic, ok := player.Strategy.(Basic); ok && basic.Bidding != nil {
+			config := *basic.Bidding
+			bidding = &config
+		}
+		snapshot.Players = append(snapshot.Players, PlayerSnapshot{
+			Name:      player.Name,
+			Computer:  player.ComputerPlayer,
+			Strategy:  strategy,
+			Bidding:   bidding,
+			Wins:      player.Wins,
+			Losses:    player.Losses,
+			TricksWon: player.TricksWon,
+			IsPlaying: player.IsPlaying,
+			CardMap:   player.CardMap,
+		})
+	}
+	for _, team := range game.Teams {
+		snapshot.Teams = append(snapshot.Teams, TeamSnapshot{Score: team.Score, TricksWon: team.TricksWon})
+	}
+	if round := e.Round; round != nil {
+		snapshot.Round = &RoundSnapshot{
+			Dealer:         round.Dealer,
+			Caller:         round.seatOf(round.Caller),
+			Defender:       round.seatOf(round.Defender),
+			DefenseOffered: round.DefenseOffered,
+			Trump:          round.Trump,
+			Lead:           round.Lead,
+			Alone:          round.Alone,
+			SelectingTrump: round.SelectingTrump,
+			ActivePlayer:   round.ActivePlayer,
+			TricksPlayed:   round.TricksPlayed,
+			Kitty:          copyCards(round.Deck.Cards),
+			Plays:          append([]PlayRecord{}, round.Plays...),
+			TurnedUp:       round.TurnedUp,
+			Bids:           append([]BidRecord{}, round.Bids...),
+			Farmed:         round.Farmed,
+			PassedOut:      round.PassedOut,
+			RenegedBy:      -1,
+		}
+		if round.Result != nil && round.Result.RenegedBy != nil {
+			snapshot.Round.RenegedBy = round.seatOf(round.Result.RenegedBy)
+		}
+	}
+	return snapshot
+}
+
+// Restore builds an engine that carries on from the snapshot
+func (snapshot *Snapshot) Restore() (*Engine, error) {
+	if snapshot.Version != snapshotVersion {
+		return nil, fmt.Errorf("%w: %d", ErrUnsupportedVersion, snapshot.Version)
+	}
+	var players []*Player
+	for seat, saved := range snapshot.Players {
+		player := &Player{
+			Name:           saved.Name,
+			ComputerPlayer: saved.Computer,
+			Wins:           saved.Wins,
+			Losses:         saved.Losses,
+			TricksWon:      saved.TricksWon,
+			IsPlaying:      saved.IsPlaying,
+			CardMap:        saved.CardMap,
+			Position:       seat,
+		}
+		if saved.Strategy != "" {
+			if err := player.UseStrategy(saved.Strategy); err != nil {
+				return nil, err
+			}
+		}
+		if saved.Bidding != nil {
+			config := *saved.Bidding
+			player.Strategy = Basic{Bidding: &config}
+		}
+		players = append(players, player)
+	}
+	game := CreateSeededGame(players, snapshot.Rules, snapshot.Seed)
+	game.source.skipTo(snapshot.Draws)
+	game.Dealer = snapshot.Dealer
+	for i, saved := range snapshot.Teams {
+		game.Teams[i].TricksWon = saved.TricksWon
+	}
+
+	engine := NewEngine(game)
+	if snapshot.Recording != nil {
+		recording := *snapshot.Recording
+		recording.Actions = append([]Action{}, snapshot.Recording.Actions...)
+		engine.Recording = &recording
+	}
+	engine.Phase = snapshot.Phase
+	engine.Trick = snapshot.Trick
+	engine.LastTrick = snapshot.LastTrick
+	if snapshot.Round != nil {
+		engine.Round = snapshot.Round.restore(game)
+		game.Rounds = []*Round{engine.Round}
+	}
+
+	// The scores are set last, rebuilding a finished hand's result adds its points again
+	for i, saved := range snapshot.Teams {
+		game.Teams[i].Score = saved.Score
+	}
+	return engine, nil
+}
+
+func (saved *RoundSnapshot) restore(game *Game) *Round {
+	player := func(seat int) *Player {
+		if seat < 0 {
+			return nil
+		}
+		return game.Players[seat]
+	}
+	round := &Round{
+		Players:        game.Players,
+		Teams:          game.Teams,
+		Dealer:         saved.Dealer,
+		Caller:         player(saved.Caller),
+		Defender:       player(saved.Defender),
+		DefenseOffered: saved.DefenseOffered,
+		Deck:           &Deck{Cards: copyCards(saved.Kitty)},
+		Trump:          saved.Trump,
+		Lead:           saved.Lead,
+		Alone:          saved.Alone,
+		SelectingTrump: saved.SelectingTrump,
+		ActivePlayer:   saved.ActivePlayer,
+		TricksPlayed:   saved.TricksPlayed,
+		Plays:          append([]PlayRecord{}, saved.Plays...),
+		TurnedUp:       saved.TurnedUp,
+		Bids:           append([]BidRecord{}, saved.Bids...),
+		Rules:          &game.Rules,
+		Rand:           game.Rand,
+		Farmed:         saved.Farmed,
+		PassedOut:      saved.PassedOut,
+	}
+	if round.HandComplete() {
+		round.ScoreHand()
+		if offender := player(saved.RenegedBy); offender != nil {
+			round.ApplyRenegePenalty(offender)
+		}
+	}
+	return round
+}
+
+func copyCards(cards []*Card) []*Card {
+	copied := make([]*Card, len(cards))
+	for i, card := range cards {
+		c := *card
+		copied[i] = &c
+	}
+	return copied
+}
+
+func (snapshot *Snapshot) Write(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(snapshot)
+}
+
+func ReadSnapshot(r io.Reader) (*Snapshot, error) {
+	snapshot := &Snapshot{}
+	if err := json.NewDecoder(r).Decode(snapshot); err != nil {
+		return nil, err
+	}
+	return snapshot, nil
+}
+
+// Save writes the snapshot to a temporary file first, so a crash while saving leaves the last save intact
+func (snapshot *Snapshot) Save(path string) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	temp := path + ".tmp"
+	file, err := os.Create(temp)
+	if err != nil {
+		return err
+	}
+	err = snapshot.Write(file)
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return err
+	}
+	return os.Rename(temp, path)
+}
+
+func LoadSnapshot(path string) (*Snapshot, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	return ReadSnapshot(file)
+}
+
+// AutosavePath is where the game in progress is saved after every trick
+func AutosavePath() (string, error) {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "euchre", "autosave.json"), nil
+}
+
+// ResumeAutosave restores the autosaved game, unless it had already finished
+func ResumeAutosave() (*Engine, error) {
+	path, err := AutosavePath()
+	if err != nil {
+		return nil, err
+	}
+	snapshot, err := LoadSnapshot(path)
+	if err != nil {
+		return nil, err
+	}
+	if snapshot.Phase == PhaseGameOver {
+		return nil, ErrWrongPhase
+	}
+	return snapshot.Restore()
+}
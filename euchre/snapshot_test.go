@@ -0,0 +1,101 @@
+package euchre
+
+import (
+	"bytes"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func roundTrip(t *testing.T, snapshot *Snapshot) *Engine {
+	var buffer bytes.Buffer
+	assert.NoError(t, snapshot.Write(&buffer))
+	loaded, err := ReadSnapshot(&buffer)
+	assert.NoError(t, err)
+	engine, err := loaded.Restore()
+	assert.NoError(t, err)
+	return engine
+}
+
+func TestResumedGameFinishesTheSame(t *testing.T) {
+	engine := NewEngine(CreateSeededGame(CreateComputerPlayers(), DefaultRules(), 11))
+	engine.NewGame(false)
+	for hands := 0; hands < 3; hands++ {
+		assert.NoError(t, engine.RunBots())
+		assert.NoError(t, engine.NextHand())
+	}
+	// Stop partway through a trick
+	for engine.Phase != PhasePlay || len(engine.CurrentTrick()) != 2 {
+		assert.True(t, step(t, engine))
+	}
+
+	resumed := roundTrip(t, engine.Snapshot())
+	assert.Equal(t, engine.Phase, resumed.Phase)
+	assert.Equal(t, engine.CurrentTrick(), resumed.CurrentTrick())
+	assert.Equal(t, teamScores(engine.Game), teamScores(resumed.Game))
+	assert.Equal(t, engine.Round.Deck.Cards[1:], resumed.Round.Deck.Cards[1:])
+	for seat, player := range engine.Game.Players {
+		assert.Equal(t, player.CardMap, resumed.Game.Players[seat].CardMap)
+	}
+
+	playToEnd(t, engine)
+	playToEnd(t, resumed)
+	assert.Equal(t, teamScores(engine.Game), teamScores(resumed.Game), "Expected the same deals after resuming")
+	for seat, player := range engine.Game.Players {
+		assert.Equal(t, player.Wins, resumed.Game.Players[seat].Wins)
+	}
+	assert.Equal(t, engine.Recording.Actions, resumed.Recording.Actions)
+}
+
+func TestResumeFinishedHandKeepsTheResult(t *testing.T) {
+	rules := DefaultRules()
+	rules.AllowReneges = true
+	engine := NewEngine(CreateSeededGame(CreateComputerPlayers(), rules, 2))
+	engine.NewGame(false)
+	assert.NoError(t, engine.RunBots())
+	assert.Equal(t, PhaseHandOver, engine.Phase)
+
+	resumed := roundTrip(t, engine.Snapshot())
+	assert.Equal(t, PhaseHandOver, resumed.Phase)
+	assert.Equal(t, teamScores(engine.Game), teamScores(resumed.Game), "Expected the hand not to be scored twice")
+	assert.Equal(t, engine.Round.Result.Describe(), resumed.Round.Result.Describe())
+	assert.NoError(t, resumed.NextHand())
+}
+
+func TestSnapshotSavesToFile(t *testing.T) {
+	engine := NewEngine(CreateSeededGame(CreateComputerPlayers(), DefaultRules(), 4))
+	engine.NewGame(false)
+	engine.Game.Players[0].Wins = 3
+	path := filepath.Join(t.TempDir(), "saves", "game.json")
+	assert.NoError(t, engine.Snapshot().Save(path))
+
+	loaded, err := LoadSnapshot(path)
+	assert.NoError(t, err)
+	resumed, err := loaded.Restore()
+	assert.NoError(t, err)
+	assert.Equal(t, 3, resumed.Game.Players[0].Wins)
+	assert.Equal(t, engine.Game.Dealer, resumed.Game.Dealer)
+	assert.Equal(t, engine.Round.UpCard(), resumed.Round.UpCard())
+}
+
+func TestRestoreRejectsOtherVersions(t *testing.T) {
+	_, err := (&Snapshot{Version: 2}).Restore()
+	assert.ErrorIs(t, err, ErrUnsupportedVersion)
+}
+
+func TestSnapshotKeepsTheBiddingProfile(t *testing.T) {
+	players := CreateComputerPlayers()
+	profile := DefaultBidding()
+	profile.Alone = 20
+	players[1].Strategy = Basic{Bidding: &profile}
+	engine := NewEngine(CreateSeededGame(players, DefaultRules(), 4))
+	engine.NewGame(false)
+
+	resumed := roundTrip(t, engine.Snapshot())
+	basic, ok := resumed.Game.Players[1].Strategy.(Basic)
+	if assert.True(t, ok) && assert.NotNil(t, basic.Bidding) {
+		assert.Equal(t, profile, *basic.Bidding)
+	}
+	assert.Nil(t, resumed.Snapshot().Players[0].Bidding, "Expected the default profile to stay the default")
+}
@@ -0,0 +1,208 @@
+package euchre
+
+// The solver plays out the rest of a hand double dummy, every hand face up, to find how many tricks each
+// partnership takes when both play perfectly. It is for analysing hands and grading the strategies,
+// none of the computer players use it since they can't see the other hands.
+
+// Position is a hand in progress with every card known. Partnerships are counted by seat, seats 0 and 2
+// are partnership 0 and seats 1 and 3 are partnership 1.
+type Position struct {
+	Hands      [4]CardMap
+	Trump      Suit
+	Lead       int      // the seat that led the current trick
+	Trick      [4]*Card // cards already played to the current trick, by seat
+	SittingOut [4]bool
+	TricksWon  [2]int // tricks each partnership has taken already
+}
+
+// Position is the engine's hand as the solver needs it
+func (e *Engine) Position() Position {
+	round := e.Round
+	position := Position{Trump: round.Trump, Lead: round.Lead, Trick: e.Trick}
+	for seat, player := range round.Players {
+		position.Hands[seat].Hand = player.CardMap.Hand
+		position.SittingOut[seat] = round.SittingOut(seat)
+	}
+	for _, done := range completedTricks(round.Plays, len(e.CurrentTrick())) {
+		position.TricksWon[round.DetermineTrickWinner(done.cards[:], done.lead)%2]++
+	}
+	return position
+}
+
+// Next is the seat to play
+func (p *Position) Next() int {
+	seat := p.Lead
+	for i := 0; i < 4; i++ {
+		if !p.SittingOut[seat] && p.Trick[seat] == nil {
+			return seat
+		}
+		seat = (seat + 1) % 4
+	}
+	return p.Lead
+}
+
+func (p *Position) currentTrick() []*Card {
+	var trick []*Card
+	for i := 0; i < 4; i++ {
+		if card := p.Trick[(p.Lead+i)%4]; card != nil {
+			trick = append(trick, card)
+		}
+	}
+	return trick
+}
+
+func (p *Position) playing() int {
+	playing := 0
+	for _, out := range p.SittingOut {
+		if !out {
+			playing++
+		}
+	}
+	return playing
+}
+
+// solverKey is a position at the start of a trick, the hands are all that's left to play with
+type solverKey struct {
+	hands [4]uint64
+	lead  int
+	trump Suit
+}
+
+// bounds are what is known about the tricks partnership 0 takes from a position
+type bounds struct {
+	lower, upper int
+}
+
+type Solver struct {
+	table map[solverKey]bounds
+	Nodes int // positions searched, for seeing how much the table saves
+}
+
+func NewSolver() *Solver {
+	return &Solver{table: map[solverKey]bounds{}}
+}
+
+// Solve is the tricks each partnership ends the hand with when both play perfectly from the position
+func (s *Solver) Solve(p Position) [2]int {
+	remaining := 0
+	for seat, hand := range p.Hands {
+		if !p.SittingOut[seat] {
+			remaining = len(hand.ToSlice())
+			if p.Trick[seat] != nil {
+				remaining++
+			}
+			break
+		}
+	}
+	ours := s.search(&p, 0, remaining)
+	return [2]int{p.TricksWon[0] + ours, p.TricksWon[1] + remaining - ours}
+}
+
+// PlayValue is what a card is worth to the seat playing it: the tricks the seat's partnership ends the hand with
+// after playing it, if everyone plays perfectly from then on
+type PlayValue struct {
+	Card   *Card
+	Tricks int
+}
+
+// PlayValues grades every legal card for the seat to play
+func (s *Solver) PlayValues(p Position) []PlayValue {
+	seat := p.Next()
+	var values []PlayValue
+	for _, card := range LegalPlays(p.Hands[seat].ToSlice(), p.currentTrick(), p.Trump) {
+		after := p
+		after.Hands[seat].Hand[card.Suit][card.Rank] = false
+		after.Trick[seat] = card
+		if len(after.currentTrick()) == after.playing() {
+			winner := (&Round{Trump: p.Trump}).DetermineTrickWinner(after.Trick[:], after.Lead)
+			after.TricksWon[winner%2]++
+			after.Lead, after.Trick = winner, [4]*Card{}
+		}
+		values = append(values, PlayValue{Card: card, Tricks: s.Solve(after)[seat%2]})
+	}
+	return values
+}
+
+// search is alpha-beta over single cards, returning the tricks partnership 0 takes from here.
+// Positions at the start of a trick go in the table with the bounds the search proved.
+func (s *Solver) search(p *Position, alpha, beta int) int {
+	s.Nodes++
+	seat := p.Next()
+	hand := p.Hands[seat].ToSlice()
+	played := len(p.currentTrick())
+	if played == 0 && len(hand) == 0 {
+		return 0
+	}
+
+	var key solverKey
+	if played == 0 {
+		key = solverKey{lead: p.Lead, trump: p.Trump}
+		for i := range p.Hands {
+			if !p.SittingOut[i] {
+				key.hands[i] = p.Hands[i].HandBits()
+			}
+		}
+		if known, ok := s.table[key]; ok {
+			if known.lower >= beta || known.lower == known.upper {
+				return known.lower
+			}
+			if known.upper <= alpha {
+				return known.upper
+			}
+			alpha, beta = max(alpha, known.lower), min(beta, known.upper)
+		}
+	}
+	alphaIn, betaIn := alpha, beta
+
+	maximizing := seat%2 == 0
+	best := -1
+	if !maximizing {
+		best = tricksPerHand + 1
+	}
+	judge := &Round{Trump: p.Trump}
+	for _, card := range LegalPlays(hand, p.currentTrick(), p.Trump) {
+		p.Hands[seat].Hand[card.Suit][card.Rank] = false
+		p.Trick[seat] = card
+		var value int
+		if played+1 == p.playing() {
+			trick, lead := p.Trick, p.Lead
+			winner := judge.DetermineTrickWinner(trick[:], lead)
+			won := 1 - winner%2 // a trick for partnership 0 counts one
+			p.Trick, p.Lead = [4]*Card{}, winner
+			value = won + s.search(p, alpha-won, beta-won)
+			p.Trick, p.Lead = trick, lead
+		} else {
+			value = s.search(p, alpha, beta)
+		}
+		p.Trick[seat] = nil
+		p.Hands[seat].Hand[card.Suit][card.Rank] = true
+
+		if maximizing {
+			best = max(best, value)
+			alpha = max(alpha, value)
+		} else {
+			best = min(best, value)
+			beta = min(beta, value)
+		}
+		if alpha >= beta {
+			break
+		}
+	}
+
+	if played == 0 {
+		known, ok := s.table[key]
+		if !ok {
+			known = bounds{0, tricksPerHand}
+		}
+		switch {
+		case best <= alphaIn:
+			known.upper = min(known.upper, best)
+		case best >= betaIn:
+			known.lower = max(known.lower, best)
+		default:
+			known = bounds{best, best}
+		}
+		s.table[key] = known
+	}
+	return best
+}
@@ -0,0 +1,143 @@
+package euchre
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// bruteForce is plain minimax with no pruning or table, the tricks partnership 0 takes from here
+func bruteForce(p Position) int {
+	seat := p.Next()
+	hand := p.Hands[seat].ToSlice()
+	if len(p.currentTrick()) == 0 && len(hand) == 0 {
+		return 0
+	}
+	best := -1
+	if seat%2 == 1 {
+		best = tricksPerHand + 1
+	}
+	for _, card := range LegalPlays(hand, p.currentTrick(), p.Trump) {
+		after := p
+		after.Hands[seat].Hand[card.Suit][card.Rank] = false
+		after.Trick[seat] = card
+		value := 0
+		if len(after.currentTrick()) == after.playing() {
+			winner := (&Round{Trump: p.Trump}).DetermineTrickWinner(after.Trick[:], after.Lead)
+			value = 1 - winner%2
+			after.Lead, after.Trick = winner, [4]*Card{}
+		}
+		value += bruteForce(after)
+		if seat%2 == 0 {
+			best = max(best, value)
+		} else {
+			best = min(best, value)
+		}
+	}
+	return best
+}
+
+// randomPosition deals cards to every seat from a shuffled deck, sometimes with part of a trick already played
+func randomPosition(rng *rand.Rand, cards int, midTrick bool) Position {
+	deck := DefaultRules().NewDeck()
+	deck.ShuffleWith(rng)
+	p := Position{Trump: Suit(rng.Intn(4)), Lead: rng.Intn(4)}
+	if rng.Intn(3) == 0 {
+		p.SittingOut[(p.Lead+1+2*rng.Intn(2))%4] = true
+	}
+	next := 0
+	for seat := 0; seat < 4; seat++ {
+		for i := 0; i < cards; i++ {
+			p.Hands[seat].AddToHand(deck.Cards[next])
+			next++
+		}
+	}
+	for played := rng.Intn(p.playing()); midTrick && played > 0; played-- {
+		seat := p.Next()
+		card := LegalPlays(p.Hands[seat].ToSlice(), p.currentTrick(), p.Trump)[0]
+		p.Hands[seat].Hand[card.Suit][card.Rank] = false
+		p.Trick[seat] = card
+	}
+	return p
+}
+
+func TestSolverAgreesWithBruteForce(t *testing.T) {
+	rng := rand.New(rand.NewSource(19))
+	solver := NewSolver()
+	for i := 0; i < 200; i++ {
+		p := randomPosition(rng, 1+rng.Intn(3), true)
+		p.TricksWon = [2]int{1, 1}
+		ours := bruteForce(p)
+		solved := solver.Solve(p)
+		assert.Equal(t, 1+ours, solved[0], "position %d", i)
+	}
+}
+
+// fixedPosition deals the cards to the seats in order
+func fixedPosition(trump Suit, lead int, hands ...[]*Card) Position {
+	p := Position{Trump: trump, Lead: lead}
+	for seat, hand := range hands {
+		for _, card := range hand {
+			p.Hands[seat].AddToHand(card)
+		}
+	}
+	return p
+}
+
+func TestSolverLetsTheAcesWin(t *testing.T) {
+	// North's aces are over East's kings in both suits, South and West can't follow
+	p := fixedPosition(Spades, 0,
+		[]*Card{NewCard(1, Hearts), NewCard(1, Clubs)},
+		[]*Card{NewCard(13, Hearts), NewCard(13, Clubs)},
+		[]*Card{NewCard(9, Diamonds), NewCard(10, Diamonds)},
+		[]*Card{NewCard(12, Diamonds), NewCard(13, Diamonds)})
+	assert.Equal(t, [2]int{2, 0}, NewSolver().Solve(p))
+
+	// East leads the king of trump into North's ace
+	p = fixedPosition(Spades, 1,
+		[]*Card{NewCard(1, Spades)},
+		[]*Card{NewCard(13, Spades)},
+		[]*Card{NewCard(9, Diamonds)},
+		[]*Card{NewCard(10, Diamonds)})
+	assert.Equal(t, [2]int{1, 0}, NewSolver().Solve(p))
+}
+
+func TestSolverSharesTheTableAcrossPositions(t *testing.T) {
+	p := randomPosition(rand.New(rand.NewSource(4)), 5, false)
+	solver := NewSolver()
+	first := solver.Solve(p)
+	nodes := solver.Nodes
+	assert.Equal(t, first, solver.Solve(p))
+	assert.Less(t, solver.Nodes-nodes, nodes, "Expected the second solve to come from the table")
+	assert.Equal(t, tricksPerHand, first[0]+first[1])
+	assert.Equal(t, bruteForce(p), first[0])
+}
+
+func TestPlayValuesGradeEveryLegalCard(t *testing.T) {
+	engine := NewEngine(CreateSeededGame(CreateComputerPlayers(), DefaultRules(), 3))
+	engine.NewGame(false)
+	for engine.Phase != PhasePlay || engine.Round.TricksPlayed != 1 || len(engine.CurrentTrick()) != 1 {
+		assert.True(t, step(t, engine))
+	}
+	position := engine.Position()
+	seat := engine.ActiveSeat()
+	assert.Equal(t, seat, position.Next())
+	assert.Equal(t, 1, position.TricksWon[0]+position.TricksWon[1])
+
+	solver := NewSolver()
+	values := solver.PlayValues(position)
+	assert.Len(t, values, len(engine.LegalPlays(seat)))
+	best := 0
+	for _, value := range values {
+		best = max(best, value.Tricks)
+	}
+	assert.Equal(t, solver.Solve(position)[seat%2], best)
+}
+
+func TestHandBits(t *testing.T) {
+	var cm CardMap
+	cm.AddToHand(NewCard(1, Spades))
+	cm.AddToHand(NewCard(9, Hearts))
+	assert.Equal(t, uint64(1)<<(int(Spades)*14+1)|uint64(1)<<(int(Hearts)*14+9), cm.HandBits())
+}
@@ -0,0 +1,85 @@
+package euchre
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+)
+
+// A Strategy makes a computer player's decisions. It only ever sees the seat's PlayerView.
+type Strategy interface {
+	Name() string
+	Bid(view *PlayerView) (Call, Suit)
+	Discard(view *PlayerView) *Card
+	Play(view *PlayerView) *Card
+}
+
+// AloneDefender is a Strategy that decides whether to defend alone, the others defend the way Basic does
+type AloneDefender interface {
+	DefendAlone(view *PlayerView) bool
+}
+
+var ErrUnknownStrategy = errors.New("unknown strategy")
+
+var (
+	strategiesMu sync.RWMutex
+	strategies   = map[string]func() Strategy{
+		"basic":      func() Strategy { return Basic{} },
+		"montecarlo": func() Strategy { return NewMonteCarlo(1, MonteCarloSamples, 0) },
+	}
+)
+
+// RegisterStrategy makes a strategy available by name, the factory is called once for every seat that uses it
+func RegisterStrategy(name string, factory func() Strategy) {
+	strategiesMu.Lock()
+	defer strategiesMu.Unlock()
+	strategies[name] = factory
+}
+
+func NewStrategy(name string) (Strategy, error) {
+	strategiesMu.RLock()
+	factory, ok := strategies[name]
+	strategiesMu.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("%w %q", ErrUnknownStrategy, name)
+	}
+	return factory(), nil
+}
+
+// StrategyNames lists the registered strategies in alphabetical order
+func StrategyNames() []string {
+	strategiesMu.RLock()
+	defer strategiesMu.RUnlock()
+	var names []string
+	for name := range strategies {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// strategy is how the player decides when the computer has the seat, Basic unless one was chosen
+func (player *Player) strategy() Strategy {
+	if player.Strategy == nil {
+		return Basic{}
+	}
+	return player.Strategy
+}
+
+// UseStrategy hands the seat to the named strategy
+func (player *Player) UseStrategy(name string) error {
+	strategy, err := NewStrategy(name)
+	if err != nil {
+		return err
+	}
+	player.Strategy = strategy
+	return nil
+}
+
+func defendAlone(strategy Strategy, view *PlayerView) bool {
+	if defender, ok := strategy.(AloneDefender); ok {
+		return defender.DefendAlone(view)
+	}
+	return Basic{}.DefendAlone(view)
+}
@@ -0,0 +1,88 @@
+package euchre
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// firstLegal always passes, discards its first card and plays its first legal card, remembering who it played for
+type firstLegal struct {
+	seats map[int]bool
+}
+
+func (s *firstLegal) Name() string {
+	return "firstLegal"
+}
+
+func (s *firstLegal) Bid(view *PlayerView) (Call, Suit) {
+	s.seats[view.Seat] = true
+	if view.DealerStuck {
+		return Basic{}.Bid(view)
+	}
+	return Pass, Suit(-1)
+}
+
+func (s *firstLegal) Discard(view *PlayerView) *Card {
+	return view.Hand[0]
+}
+
+func (s *firstLegal) Play(view *PlayerView) *Card {
+	s.seats[view.Seat] = true
+	return view.LegalPlays[0]
+}
+
+func init() {
+	RegisterStrategy("firstLegal", func() Strategy { return &firstLegal{seats: map[int]bool{}} })
+}
+
+func TestStrategyRegistry(t *testing.T) {
+	basic, err := NewStrategy("basic")
+	assert.NoError(t, err)
+	assert.Equal(t, Basic{}, basic)
+	assert.Contains(t, StrategyNames(), "firstLegal")
+
+	_, err = NewStrategy("psychic")
+	assert.ErrorIs(t, err, ErrUnknownStrategy)
+	player := &Player{}
+	assert.Error(t, player.UseStrategy("psychic"))
+	assert.Equal(t, Basic{}, player.strategy())
+}
+
+func TestSeatsRunTheirOwnStrategy(t *testing.T) {
+	players := CreateComputerPlayers()
+	assert.NoError(t, players[1].UseStrategy("firstLegal"))
+	assert.NoError(t, players[3].UseStrategy("firstLegal"))
+	east, west := players[1].Strategy.(*firstLegal), players[3].Strategy.(*firstLegal)
+	assert.NotSame(t, east, west, "Expected every seat to get its own strategy")
+
+	engine := NewEngine(CreateSeededGame(players, DefaultRules(), 17))
+	calls := map[int]int{}
+	engine.Subscribe(func(event Event) {
+		if event.Type == EventBid && event.Call != Pass {
+			calls[event.Seat]++
+		}
+	})
+	engine.NewGame(false)
+	playToEnd(t, engine)
+
+	assert.Equal(t, map[int]bool{1: true}, east.seats)
+	assert.Equal(t, map[int]bool{3: true}, west.seats)
+	assert.NotZero(t, calls[0]+calls[2], "Expected the basic seats to call trump")
+}
+
+func TestSnapshotKeepsTheStrategies(t *testing.T) {
+	players := CreateComputerPlayers()
+	assert.NoError(t, players[2].UseStrategy("firstLegal"))
+	engine := NewEngine(CreateSeededGame(players, DefaultRules(), 2))
+	engine.NewGame(false)
+
+	resumed := roundTrip(t, engine.Snapshot())
+	assert.Nil(t, resumed.Game.Players[0].Strategy)
+	assert.Equal(t, "firstLegal", resumed.Game.Players[2].Strategy.Name())
+
+	snapshot := engine.Snapshot()
+	snapshot.Players[1].Strategy = "psychic"
+	_, err := snapshot.Restore()
+	assert.ErrorIs(t, err, ErrUnknownStrategy)
+}
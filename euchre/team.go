@@ -0,0 +1,73 @@
+package euchre
+
+import "strings"
+
+// Team is a partnership of the players sitting across from each other.
+// Points are scored by the team, so partners can never disagree on the score.
+type Team struct {
+	Name      string
+	Members   []*Player
+	Score     int
+	TricksWon int           // tricks taken in the current hand
+	History   []*HandResult // every hand this team has played this game
+}
+
+// FormTeams partners each seat with the seat across the table and records the seating on the players.
+func FormTeams(players []*Player) []*Team {
+	teamCount := len(players) / 2
+	teams := make([]*Team, teamCount)
+	for i := range teams {
+		teams[i] = &Team{}
+	}
+	for seat, player := range players {
+		team := teams[seat%teamCount]
+		team.Members = append(team.Members, player)
+		player.Position = seat
+		player.Team = team
+	}
+	for _, team := range teams {
+		var names []string
+		for _, member := range team.Members {
+			names = append(names, member.Name)
+		}
+		team.Name = strings.Join(names, " & ")
+	}
+	return teams
+}
+
+func (team *Team) Has(player *Player) bool {
+	for _, member := range team.Members {
+		if member == player {
+			return true
+		}
+	}
+	return false
+}
+
+func (team *Team) PartnerOf(player *Player) *Player {
+	if !team.Has(player) {
+		return nil
+	}
+	for _, member := range team.Members {
+		if member != player {
+			return member
+		}
+	}
+	return nil
+}
+
+func (team *Team) AddPoints(points int) {
+	team.Score += points
+}
+
+// ResetHand clears the per-hand state ahead of a new deal
+func (team *Team) ResetHand() {
+	team.TricksWon = 0
+}
+
+// ResetGame clears everything but the members ahead of a new game
+func (team *Team) ResetGame() {
+	team.Score = 0
+	team.TricksWon = 0
+	team.History = nil
+}
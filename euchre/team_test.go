@@ -0,0 +1,43 @@
+package euchre
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormTeamsPartnersAcrossTheTable(t *testing.T) {
+	players := CreatePlayers()
+	teams := FormTeams(players)
+	assert.Len(t, teams, 2)
+	assert.Equal(t, []*Player{players[0], players[2]}, teams[0].Members)
+	assert.Equal(t, []*Player{players[1], players[3]}, teams[1].Members)
+	assert.Equal(t, "Chris & MaryAnn", teams[0].Name)
+	for seat, player := range players {
+		assert.Equal(t, seat, player.Position)
+	}
+}
+
+func TestPartnersShareScore(t *testing.T) {
+	players := CreatePlayers()
+	FormTeams(players)
+	players[1].Team.AddPoints(2)
+	assert.Equal(t, 2, players[3].Team.Score)
+	assert.Equal(t, 0, players[0].Team.Score)
+}
+
+func TestPartnerOf(t *testing.T) {
+	players := CreatePlayers()
+	teams := FormTeams(players)
+	assert.Same(t, players[3], teams[1].PartnerOf(players[1]))
+	assert.Nil(t, teams[1].PartnerOf(players[0]), "Expected no partner for a player on the other team")
+	assert.Same(t, players[2], players[0].getPartner(players))
+}
+
+func TestResetGameClearsScoreAndHistory(t *testing.T) {
+	team := &Team{Score: 7, TricksWon: 3, History: []*HandResult{{Points: 1}}}
+	team.ResetGame()
+	assert.Equal(t, 0, team.Score)
+	assert.Equal(t, 0, team.TricksWon)
+	assert.Empty(t, team.History)
+}
@@ -0,0 +1,278 @@
+package euchre
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// Terminal plays a game in text mode, for machines without a display. It drives the same engine as the GUI,
+// one human seat reading its decisions from the input and the computer players doing the rest.
+type Terminal struct {
+	Engine   *Engine
+	Seat     int
+	Autosave string // where the game is saved after every trick, empty for nowhere
+	in       *bufio.Scanner
+	out      io.Writer
+
+	autosavePending bool
+}
+
+var errInputClosed = errors.New("input closed before the game was over")
+
+func NewTerminal(engine *Engine, seat int, in io.Reader, out io.Writer) *Terminal {
+	term := &Terminal{Engine: engine, Seat: seat, in: bufio.NewScanner(in), out: out}
+	engine.Subscribe(term.onEngineEvent)
+	return term
+}
+
+// Run plays until the game is over
+func (term *Terminal) Run() error {
+	e := term.Engine
+	for {
+		if err := e.RunBots(); err != nil {
+			return err
+		}
+		if term.autosavePending {
+			term.autosave()
+		}
+		var err error
+		switch e.Phase {
+		case PhaseBidding:
+			err = term.bid()
+		case PhaseDefendAlone:
+			err = term.defendAlone()
+		case PhaseDiscard:
+			err = term.discard()
+		case PhasePlay:
+			err = term.play()
+		case PhaseHandOver:
+			err = term.handOver()
+		case PhaseGameOver:
+			fmt.Fprintf(term.out, "%s win the game!\n", e.Game.Winner().Name)
+			return nil
+		default:
+			return ErrWrongPhase
+		}
+		if err == errInputClosed {
+			return err
+		}
+		if err != nil {
+			fmt.Fprintln(term.out, err)
+		}
+	}
+}
+
+// ask prompts for a line of input, trimmed and lower case
+func (term *Terminal) ask(prompt string) (string, error) {
+	fmt.Fprintf(term.out, "%s> ", prompt)
+	if !term.in.Scan() {
+		return "", errInputClosed
+	}
+	return strings.ToLower(strings.TrimSpace(term.in.Text())), nil
+}
+
+func (term *Terminal) bid() error {
+	round := term.Engine.Round
+	term.showHand()
+	if term.Engine.FirstBiddingRound() {
+		prompt := fmt.Sprintf("Up card is the %s. order, alone or pass", cardName(round.UpCard()))
+		if round.HasFarmersHand(term.Seat) {
+			prompt += " (or farmer)"
+		}
+		answer, err := term.ask(prompt)
+		if err != nil {
+			return err
+		}
+		switch answer {
+		case "order", "o":
+			return term.Engine.Bid(term.Seat, OrderUp, round.UpCard().Suit)
+		case "alone", "a":
+			return term.Engine.Bid(term.Seat, Alone, round.UpCard().Suit)
+		case "pass", "p":
+			return term.Engine.Bid(term.Seat, Pass, Suit(-1))
+		case "farmer", "f":
+			return term.Engine.FarmersExchange(term.Seat, FarmersDiscards(round.Players[term.Seat].CardMap.ToSlice()))
+		}
+		return fmt.Errorf("%q isn't a bid", answer)
+	}
+
+	prompt := fmt.Sprintf("%s was turned down. Name a suit, add alone to go alone", round.UpCard().Suit.FriendlySuit())
+	if !round.DealerIsStuck() {
+		prompt += ", or pass"
+	}
+	answer, err := term.ask(prompt)
+	if err != nil {
+		return err
+	}
+	words := strings.Fields(answer)
+	if len(words) == 0 {
+		return errors.New("name a suit or pass")
+	}
+	if words[0] == "pass" || words[0] == "p" {
+		return term.Engine.Bid(term.Seat, Pass, Suit(-1))
+	}
+	suit, ok := parseSuit(words[0])
+	if !ok {
+		return fmt.Errorf("%q isn't a suit", words[0])
+	}
+	call := OrderUp
+	if len(words) > 1 && (words[1] == "alone" || words[1] == "a") {
+		call = Alone
+	}
+	return term.Engine.Bid(term.Seat, call, suit)
+}
+
+func (term *Terminal) defendAlone() error {
+	round := term.Engine.Round
+	term.showHand()
+	answer, err := term.ask(fmt.Sprintf("%s is going alone in %s. defend alone or pass", round.Caller.Name, round.Trump.FriendlySuit()))
+	if err != nil {
+		return err
+	}
+	switch answer {
+	case "defend", "d", "alone", "a":
+		return term.Engine.DefendAlone(term.Seat, true)
+	case "pass", "p":
+		return term.Engine.DefendAlone(term.Seat, false)
+	}
+	return fmt.Errorf("%q isn't an answer", answer)
+}
+
+func (term *Terminal) discard() error {
+	if plan := term.Engine.View(term.Seat).DiscardPlan; plan != nil && plan.Card != nil {
+		fmt.Fprintf(term.out, "Suggested: %s\n", plan.Reason)
+	}
+	card, err := term.chooseCard("Pick a card to discard", nil)
+	if err != nil {
+		return err
+	}
+	return term.Engine.Discard(term.Seat, card)
+}
+
+func (term *Terminal) play() error {
+	if trick := term.Engine.CurrentTrick(); len(trick) > 0 {
+		var names []string
+		for _, card := range trick {
+			names = append(names, cardName(card))
+		}
+		fmt.Fprintf(term.out, "Trick so far: %s\n", strings.Join(names, ", "))
+	}
+	card, err := term.chooseCard(fmt.Sprintf("Trump is %s. Pick a card to play", term.Engine.Round.Trump.FriendlySuit()),
+		term.Engine.LegalPlays(term.Seat))
+	if err != nil {
+		return err
+	}
+	return term.Engine.Play(term.Seat, card)
+}
+
+// chooseCard shows the hand numbered from one, marking the cards that can't be played
+func (term *Terminal) chooseCard(prompt string, legal []*Card) (*Card, error) {
+	hand := term.showHand()
+	if legal != nil {
+		for i, card := range hand {
+			if !ContainsCard(legal, card) {
+				fmt.Fprintf(term.out, "  (%d must follow suit)\n", i+1)
+			}
+		}
+	}
+	answer, err := term.ask(prompt)
+	if err != nil {
+		return nil, err
+	}
+	choice, err := strconv.Atoi(answer)
+	if err != nil || choice < 1 || choice > len(hand) {
+		return nil, fmt.Errorf("pick a card from 1 to %d", len(hand))
+	}
+	return hand[choice-1], nil
+}
+
+func (term *Terminal) handOver() error {
+	prompt := "Press enter for the next hand"
+	if term.Engine.Round.rules().AllowReneges {
+		prompt += ", or type renege to call one"
+	}
+	answer, err := term.ask(prompt)
+	if err != nil {
+		return err
+	}
+	if answer == "renege" {
+		_, err := term.Engine.CallRenege(term.Seat)
+		return err
+	}
+	return term.Engine.NextHand()
+}
+
+func (term *Terminal) showHand() []*Card {
+	hand := term.Engine.Round.Players[term.Seat].CardMap.ToSlice()
+	fmt.Fprintln(term.out, "Your hand:")
+	for i, card := range hand {
+		fmt.Fprintf(term.out, "  %d) %s\n", i+1, cardName(card))
+	}
+	return hand
+}
+
+func (term *Terminal) onEngineEvent(event Event) {
+	e := term.Engine
+	switch event.Type {
+	case EventDeal:
+		fmt.Fprintf(term.out, "\n%s deals. Score %s\n", e.Round.Players[event.Seat].Name, scoreLine(e.Game))
+	case EventBid:
+		suit := ""
+		if event.Call != Pass {
+			suit = " " + event.Suit.FriendlySuit()
+		}
+		fmt.Fprintf(term.out, "%s: %s%s\n", e.Round.Players[event.Seat].Name, event.Call.FriendlyCall(), suit)
+	case EventThrowIn:
+		fmt.Fprintln(term.out, "Everyone passed, the hand is thrown in")
+	case EventDefendAlone:
+		if event.Call == Alone {
+			fmt.Fprintf(term.out, "%s is defending alone\n", e.Round.Players[event.Seat].Name)
+		}
+	case EventPlay:
+		fmt.Fprintf(term.out, "%s plays the %s\n", e.Round.Players[event.Seat].Name, cardName(event.Card))
+	case EventTrickWon:
+		fmt.Fprintf(term.out, "%s takes the trick\n", e.Round.Players[event.Seat].Name)
+		term.autosavePending = term.Autosave != "" // saved once the engine has settled
+	case EventHandScored, EventRenege:
+		if event.Result != nil {
+			fmt.Fprintln(term.out, event.Result.Describe())
+		}
+	}
+}
+
+func (term *Terminal) autosave() {
+	term.autosavePending = false
+	if err := term.Engine.Snapshot().Save(term.Autosave); err != nil {
+		fmt.Fprintln(term.out, "Autosave failed:", err)
+	}
+}
+
+func scoreLine(game *Game) string {
+	var scores []string
+	for _, team := range game.Teams {
+		scores = append(scores, fmt.Sprintf("%s %d", team.Name, team.Score))
+	}
+	return strings.Join(scores, ", ")
+}
+
+func cardName(card *Card) string {
+	if card.IsJoker() {
+		return card.FriendlyRank()
+	}
+	return fmt.Sprintf("%s of %s", card.FriendlyRank(), card.Suit.FriendlySuit())
+}
+
+// parseSuit reads a suit by name or first letter, n for no trump
+func parseSuit(word string) (Suit, bool) {
+	for _, suit := range []Suit{Spades, Diamonds, Clubs, Hearts, NoTrump} {
+		name := strings.ToLower(strings.ReplaceAll(suit.FriendlySuit(), " ", ""))
+		if word == name || word == name[:1] {
+			return suit, true
+		}
+	}
+	return Suit(-1), false
+}
@@ -0,0 +1,78 @@
+package euchre
+
+import (
+	"bytes"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func createTerminalGame(seed int64) *Engine {
+	players := CreateComputerPlayers()
+	players[2].ComputerPlayer = false
+	engine := NewEngine(CreateSeededGame(players, DefaultRules(), seed))
+	engine.NewGame(false)
+	return engine
+}
+
+func TestTerminalPlaysAFullGame(t *testing.T) {
+	engine := createTerminalGame(8)
+	// Answers that are wrong for the moment are rejected and the next line is tried
+	input := strings.Repeat("pass\n1\n2\n3\n4\n5\n6\n", 2000)
+	var output bytes.Buffer
+	assert.NoError(t, NewTerminal(engine, 2, strings.NewReader(input), &output).Run())
+
+	assert.Equal(t, PhaseGameOver, engine.Phase)
+	text := output.String()
+	assert.Contains(t, text, "win the game!")
+	assert.Contains(t, text, "Your hand:")
+	assert.Contains(t, text, "takes the trick")
+}
+
+func TestTerminalAutosavesAfterATrick(t *testing.T) {
+	engine := createTerminalGame(8)
+	input := strings.Repeat("pass\n1\n2\n3\n4\n5\n6\n", 20)
+	term := NewTerminal(engine, 2, strings.NewReader(input), &bytes.Buffer{})
+	term.Autosave = filepath.Join(t.TempDir(), "autosave.json")
+	assert.ErrorIs(t, term.Run(), errInputClosed)
+
+	saved, err := LoadSnapshot(term.Autosave)
+	if assert.NoError(t, err) {
+		assert.Equal(t, engine.Game.ID, saved.GameID)
+		resumed, err := saved.Restore()
+		assert.NoError(t, err)
+		assert.Equal(t, teamScores(engine.Game), teamScores(resumed.Game))
+	}
+}
+
+func TestTerminalReadsASecondRoundCall(t *testing.T) {
+	engine := createTerminalGame(8)
+	for engine.ActiveSeat() != 2 || engine.FirstBiddingRound() {
+		assert.NoError(t, engine.Bid(engine.ActiveSeat(), Pass, Suit(-1)))
+	}
+	suit := (engine.Round.UpCard().Suit + 1) % 4
+	var output bytes.Buffer
+	term := NewTerminal(engine, 2, strings.NewReader(strings.ToLower(suit.FriendlySuit())+" alone\n"), &output)
+	assert.NoError(t, term.bid())
+	assert.Equal(t, suit, engine.Round.Trump)
+	assert.True(t, engine.Round.Alone)
+	assert.Same(t, engine.Round.Players[2], engine.Round.Caller)
+}
+
+func TestTerminalStopsWhenInputCloses(t *testing.T) {
+	engine := createTerminalGame(8)
+	var output bytes.Buffer
+	assert.ErrorIs(t, NewTerminal(engine, 2, strings.NewReader(""), &output).Run(), errInputClosed)
+}
+
+func TestParseSuit(t *testing.T) {
+	suit, ok := parseSuit("h")
+	assert.True(t, ok)
+	assert.Equal(t, Hearts, suit)
+	suit, _ = parseSuit("notrump")
+	assert.Equal(t, NoTrump, suit)
+	_, ok = parseSuit("stars")
+	assert.False(t, ok)
+}
@@ -0,0 +1,284 @@
+package euchre
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"math"
+	"strings"
+	"text/tabwriter"
+)
+
+// A Tournament plays every pair of strategies against each other, each strategy as a partnership. The deals
+// are duplicated: every seed is played twice with the teams swapping seats, so both sides get the same cards.
+type Tournament struct {
+	Strategies []string
+	Deals      int   // seeds played by each pairing, two games apiece
+	Seed       int64 // the first seed, the rest follow on from it
+	Rules      Rules
+
+	NewStrategy func(name string) (Strategy, error) // makes every seat's strategy, the registry's NewStrategy when nil
+}
+
+// A StrategyRecord is how one strategy did over the whole tournament
+type StrategyRecord struct {
+	Name      string
+	Games     int
+	Wins      int
+	Hands     int
+	Points    int     // points the partnership scored
+	Called    int     // hands the partnership called trump
+	Euchred   int     // hands it called and was euchred
+	Elo       float64 // the strategies average 1500
+	EloMargin float64 // the Elo is within this much either way 95% of the time
+}
+
+func (record *StrategyRecord) WinRate() float64 {
+	return ratio(record.Wins, record.Games)
+}
+
+func (record *StrategyRecord) PointsPerHand() float64 {
+	return ratio(record.Points, record.Hands)
+}
+
+// EuchreRate is how often the partnership was euchred when it called trump
+func (record *StrategyRecord) EuchreRate() float64 {
+	return ratio(record.Euchred, record.Called)
+}
+
+func ratio(count, total int) float64 {
+	if total == 0 {
+		return 0
+	}
+	return float64(count) / float64(total)
+}
+
+type TournamentResult struct {
+	Records []*StrategyRecord // in the order the strategies were given
+	wins    [][]float64       // games each strategy won against each other strategy
+}
+
+var ErrTooFewStrategies = errors.New("a tournament needs at least two strategies")
+
+// maxSteps stops a game that never finishes, thousands of times longer than any real game
+const maxSteps = 100000
+
+func (t Tournament) Run() (*TournamentResult, error) {
+	if len(t.Strategies) < 2 {
+		return nil, ErrTooFewStrategies
+	}
+	result := &TournamentResult{wins: make([][]float64, len(t.Strategies))}
+	for i, name := range t.Strategies {
+		result.Records = append(result.Records, &StrategyRecord{Name: name})
+		result.wins[i] = make([]float64, len(t.Strategies))
+	}
+	for i := range t.Strategies {
+		for j := i + 1; j < len(t.Strategies); j++ {
+			for deal := 0; deal < t.Deals; deal++ {
+				seed := t.Seed + int64(deal)
+				for _, swapped := range []bool{false, true} {
+					if err := t.playGame(result, i, j, seed, swapped); err != nil {
+						return nil, err
+					}
+				}
+			}
+		}
+	}
+	result.rate()
+	return result, nil
+}
+
+// playGame plays one game between the two strategies, the first sitting North and South unless swapped
+func (t Tournament) playGame(result *TournamentResult, first, second int, seed int64, swapped bool) error {
+	newStrategy := t.NewStrategy
+	if newStrategy == nil {
+		newStrategy = NewStrategy
+	}
+	var factories [2]func() Strategy
+	for side, index := range []int{first, second} {
+		name := t.Strategies[index]
+		if _, err := newStrategy(name); err != nil {
+			return err
+		}
+		factories[side] = func() Strategy {
+			strategy, _ := newStrategy(name)
+			return strategy
+		}
+	}
+	players, game := newMatch(factories, t.Rules, seed, swapped)
+
+	records := [2]*StrategyRecord{result.Records[first], result.Records[second]}
+	teams := [2]*Team{players[0].Team, players[1].Team}
+	engine := NewEngine(game)
+	engine.Subscribe(func(event Event) {
+		if event.Type != EventHandScored {
+			return
+		}
+		for side, record := range records {
+			record.Hands++
+			if event.Result == nil {
+				continue
+			}
+			if event.Result.Scorer == teams[side] {
+				record.Points += event.Result.Points
+			}
+			if event.Result.Makers == teams[side] {
+				record.Called++
+				if event.Result.Euchred {
+					record.Euchred++
+				}
+			}
+		}
+	})
+	engine.NewGame(false)
+	if err := playToTheEnd(engine); err != nil {
+		return fmt.Errorf("game %s: %w", game.ID, err)
+	}
+
+	// RecordResults has credited the win to both partners
+	for side, record := range records {
+		record.Games++
+		record.Wins += players[side].Wins
+	}
+	winner, loser := first, second
+	if players[1].Wins > 0 {
+		winner, loser = second, first
+	}
+	result.wins[winner][loser]++
+	return nil
+}
+
+// newMatch seats two partnerships, each with a strategy of its own. The first sits North and South and the second
+// East and West, unless swapped. The players are returned in that order, whatever seats they end up in.
+func newMatch(strategies [2]func() Strategy, rules Rules, seed int64, swapped bool) ([]*Player, *Game) {
+	var players []*Player
+	for seat := 0; seat < 4; seat++ {
+		strategy := strategies[seat%2]()
+		players = append(players, &Player{Name: fmt.Sprintf("%s %d", strategy.Name(), seat/2+1),
+			ComputerPlayer: true, IsPlaying: true, Strategy: strategy})
+	}
+	game := CreateSeededGame(append([]*Player{}, players...), rules, seed)
+	if swapped {
+		game.SwapSides()
+	}
+	return players, game
+}
+
+// playToTheEnd lets the computer players play the whole game
+func playToTheEnd(engine *Engine) error {
+	for steps := 0; engine.Phase != PhaseGameOver; steps++ {
+		if steps > maxSteps {
+			return errors.New("the game never finished")
+		}
+		moved, err := engine.Step()
+		if err != nil {
+			return err
+		}
+		if !moved {
+			if err := engine.NextHand(); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// rate fits an Elo rating to every strategy from the games won and lost, by the Bradley-Terry model.
+// Each pairing starts with half a win to each side, so a strategy that never loses still gets a finite rating.
+func (result *TournamentResult) rate() {
+	n := len(result.Records)
+	wins := make([][]float64, n)
+	for i := range wins {
+		wins[i] = make([]float64, n)
+		for j := range wins[i] {
+			if i != j && result.wins[i][j]+result.wins[j][i] > 0 {
+				wins[i][j] = result.wins[i][j] + 0.5
+			}
+		}
+	}
+
+	strength := make([]float64, n)
+	for i := range strength {
+		strength[i] = 1
+	}
+	for round := 0; round < 1000; round++ {
+		for i := range strength {
+			won, expected := 0.0, 0.0
+			for j := range strength {
+				won += wins[i][j]
+				expected += (wins[i][j] + wins[j][i]) / (strength[i] + strength[j])
+			}
+			if expected > 0 {
+				strength[i] = won / expected
+			}
+		}
+		// Keep the strengths' geometric mean at one, so the average rating stays at 1500
+		mean := 0.0
+		for _, s := range strength {
+			mean += math.Log(s)
+		}
+		mean = math.Exp(mean / float64(n))
+		for i := range strength {
+			strength[i] /= mean
+		}
+	}
+
+	const eloScale = 400 / math.Ln10
+	for i, record := range result.Records {
+		record.Elo = 1500 + eloScale*math.Log(strength[i])
+		information := 0.0
+		for j := range strength {
+			p := strength[i] / (strength[i] + strength[j])
+			information += (wins[i][j] + wins[j][i]) * p * (1 - p)
+		}
+		if information > 0 {
+			record.EloMargin = 1.96 * eloScale / math.Sqrt(information)
+		}
+	}
+}
+
+func (result *TournamentResult) Report(w io.Writer) {
+	table := tabwriter.NewWriter(w, 0, 0, 2, ' ', tabwriter.AlignRight)
+	fmt.Fprintln(table, "Strategy\tGames\tWon\tPoints/hand\tEuchred\tElo\t")
+	for _, record := range result.Records {
+		fmt.Fprintf(table, "%s\t%d\t%.1f%%\t%.3f\t%.1f%%\t%.0f ± %.0f\t\n", record.Name, record.Games,
+			100*record.WinRate(), record.PointsPerHand(), 100*record.EuchreRate(), record.Elo, record.EloMargin)
+	}
+	table.Flush()
+}
+
+// TournamentCommand runs a tournament from the command line: euchre tournament -strategies basic,montecarlo
+func TournamentCommand(args []string, out io.Writer) error {
+	flags := flag.NewFlagSet("tournament", flag.ContinueOnError)
+	flags.SetOutput(out)
+	names := flags.String("strategies", "basic,montecarlo", "strategies to play against each other, separated by commas: "+
+		strings.Join(StrategyNames(), ", "))
+	deals := flags.Int("deals", 500, "seeds each pair of strategies plays, each one twice with the seats swapped")
+	seed := flags.Int64("seed", 1, "the first seed, the same seed plays the same deals")
+	samples := flags.Int("samples", MonteCarloSamples, "deals the montecarlo strategy tries for each card")
+	if err := flags.Parse(args); errors.Is(err, flag.ErrHelp) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	result, err := Tournament{
+		Strategies: strings.Split(*names, ","),
+		Deals:      *deals,
+		Seed:       *seed,
+		Rules:      DefaultRules(),
+		NewStrategy: func(name string) (Strategy, error) {
+			// The montecarlo seats are seeded from the tournament, so the same seed plays the same way
+			if name == "montecarlo" {
+				return NewMonteCarlo(*seed, *samples, 0), nil
+			}
+			return NewStrategy(name)
+		},
+	}.Run()
+	if err != nil {
+		return err
+	}
+	result.Report(out)
+	return nil
+}
@@ -0,0 +1,76 @@
+package euchre
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSwapSidesKeepsPartnersAndDeals(t *testing.T) {
+	players := CreateComputerPlayers()
+	game := CreateSeededGame(append([]*Player{}, players...), DefaultRules(), 12)
+	swapped := CreateSeededGame(append([]*Player{}, players...), DefaultRules(), 12)
+	swapped.SwapSides()
+	assert.Equal(t, players[0], swapped.Players[1])
+	assert.True(t, swapped.Teams[1].Has(players[2]), "Expected partners to stay together")
+
+	game.NewGame(false)
+	dealt := map[int][]*Card{}
+	for seat, player := range game.Players {
+		dealt[seat] = player.CardMap.ToSlice()
+	}
+	swapped.NewGame(false)
+	for seat, player := range swapped.Players {
+		assert.Equal(t, dealt[seat], player.CardMap.ToSlice(), "Expected seat %d to get the same cards", seat)
+	}
+}
+
+func TestTournamentPlaysDuplicateGames(t *testing.T) {
+	result, err := Tournament{Strategies: []string{"basic", "firstLegal"}, Deals: 10, Seed: 3, Rules: DefaultRules()}.Run()
+	assert.NoError(t, err)
+	basic, passer := result.Records[0], result.Records[1]
+	assert.Equal(t, 20, basic.Games)
+	assert.Equal(t, 20, passer.Games)
+	assert.Equal(t, 20, basic.Wins+passer.Wins)
+	assert.Equal(t, basic.Hands, passer.Hands)
+	assert.Greater(t, basic.WinRate(), 0.8, "Expected basic to beat a strategy that never calls")
+	assert.Greater(t, basic.Elo, passer.Elo+basic.EloMargin)
+	assert.InDelta(t, 3000, basic.Elo+passer.Elo, 0.001)
+	assert.Greater(t, basic.PointsPerHand(), passer.PointsPerHand())
+	assert.Positive(t, basic.Called)
+	assert.LessOrEqual(t, basic.Euchred, basic.Called)
+}
+
+func TestTournamentIsRepeatable(t *testing.T) {
+	tournament := Tournament{Strategies: []string{"basic", "basic"}, Deals: 5, Seed: 9, Rules: DefaultRules()}
+	first, err := tournament.Run()
+	assert.NoError(t, err)
+	second, err := tournament.Run()
+	assert.NoError(t, err)
+	assert.Equal(t, first.Records, second.Records)
+	// The same strategy gets the same cards from both sides, so neither can be rated much higher
+	assert.InDelta(t, first.Records[0].Elo, first.Records[1].Elo, 2*first.Records[0].EloMargin)
+}
+
+func TestTournamentNeedsTwoStrategies(t *testing.T) {
+	_, err := Tournament{Strategies: []string{"basic"}, Deals: 1}.Run()
+	assert.ErrorIs(t, err, ErrTooFewStrategies)
+	_, err = Tournament{Strategies: []string{"basic", "nobody"}, Deals: 1, Rules: DefaultRules()}.Run()
+	assert.ErrorIs(t, err, ErrUnknownStrategy)
+}
+
+func TestTournamentCommandReports(t *testing.T) {
+	var out bytes.Buffer
+	assert.NoError(t, TournamentCommand([]string{"-strategies", "basic,firstLegal", "-deals", "2"}, &out))
+	assert.Contains(t, out.String(), "Elo")
+	assert.Contains(t, out.String(), "firstLegal")
+}
+
+func TestTournamentCommandLeavesTheRegistryAlone(t *testing.T) {
+	var out bytes.Buffer
+	assert.NoError(t, TournamentCommand([]string{"-strategies", "basic,montecarlo", "-deals", "1", "-samples", "3"}, &out))
+	strategy, err := NewStrategy("montecarlo")
+	assert.NoError(t, err)
+	assert.Equal(t, MonteCarloSamples, strategy.(*MonteCarlo).Samples)
+}
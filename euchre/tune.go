@@ -0,0 +1,174 @@
+package euchre
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"runtime"
+	"sync"
+)
+
+// A Tuner searches for a better bidding profile by coordinate descent through self-play. A step nudges one weight
+// or threshold up or down by one, and the change is kept when Basic bidding with it outscores Basic bidding with
+// the profile so far. Every trial plays the same duplicate deals, so a change is only kept for the cards it was
+// tried on and more deals make a more trustworthy profile.
+type Tuner struct {
+	Start   BiddingConfig
+	Deals   int // duplicate deals each trial plays, two games apiece
+	Seed    int64
+	Rounds  int // passes over every parameter
+	Workers int // runtime.NumCPU() when zero
+	Rules   Rules
+	Log     io.Writer // where kept changes are reported, nil for nowhere
+}
+
+// A tunable is one number in a profile the tuner can change
+type tunable struct {
+	name  string
+	value *int
+}
+
+// tunables lists every number the tuner searches
+func (config *BiddingConfig) tunables() []tunable {
+	params := []tunable{
+		{"right", &config.Weights.Right},
+		{"leftWithTrump", &config.Weights.LeftWithTrump},
+		{"left", &config.Weights.Left},
+		{"trump", &config.Weights.Trump},
+		{"offAce", &config.Weights.OffAce},
+		{"void", &config.Weights.Void},
+		{"alone", &config.Alone},
+		{"defendAlone", &config.DefendAlone},
+		{"next", &config.Next},
+		{"cross", &config.Cross},
+		{"upBower", &config.UpBower},
+		{"upTrump", &config.UpTrump},
+	}
+	seats := []string{"first", "second", "third", "dealer"}
+	for seat := range seats {
+		params = append(params,
+			tunable{"order " + seats[seat], &config.Order[seat]},
+			tunable{"name " + seats[seat], &config.Name[seat]})
+	}
+	return params
+}
+
+func (tuner Tuner) Run() (BiddingConfig, error) {
+	best := tuner.Start
+	for round := 0; round < tuner.Rounds; round++ {
+		improved := false
+		for i := range best.tunables() {
+			for _, step := range []int{1, -1} {
+				candidate := best
+				param := candidate.tunables()[i]
+				if *param.value+step < 0 {
+					continue
+				}
+				*param.value += step
+				margin, err := tuner.margin(candidate, best)
+				if err != nil {
+					return best, err
+				}
+				if margin > 0 {
+					if tuner.Log != nil {
+						fmt.Fprintf(tuner.Log, "%s %d, won by %d points\n", param.name, *param.value, margin)
+					}
+					best, improved = candidate, true
+					break
+				}
+			}
+		}
+		if !improved {
+			break
+		}
+	}
+	return best, nil
+}
+
+// margin is how many more points the candidate's partnership scored than the incumbent's over the duplicate deals
+func (tuner Tuner) margin(candidate, incumbent BiddingConfig) (int, error) {
+	workers := tuner.Workers
+	if workers <= 0 {
+		workers = runtime.NumCPU()
+	}
+	strategies := [2]func() Strategy{
+		func() Strategy { return Basic{Bidding: &candidate} },
+		func() Strategy { return Basic{Bidding: &incumbent} },
+	}
+
+	games := make(chan int)
+	margins := make([]int, workers)
+	errs := make([]error, workers)
+	var wg sync.WaitGroup
+	for worker := range margins {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for game := range games {
+				if errs[worker] != nil {
+					continue
+				}
+				players, match := newMatch(strategies, tuner.Rules, tuner.Seed+int64(game/2), game%2 == 1)
+				engine := NewEngine(match)
+				engine.NewGame(false)
+				if errs[worker] = playToTheEnd(engine); errs[worker] == nil {
+					margins[worker] += players[0].Team.Score - players[1].Team.Score
+				}
+			}
+		}()
+	}
+	for game := 0; game < 2*tuner.Deals; game++ {
+		games <- game
+	}
+	close(games)
+	wg.Wait()
+
+	margin := 0
+	for _, m := range margins {
+		margin += m
+	}
+	return margin, errors.Join(errs...)
+}
+
+// TuneCommand tunes a bidding profile from the command line: euchre tune -out profile.json
+func TuneCommand(args []string, out io.Writer) error {
+	flags := flag.NewFlagSet("tune", flag.ContinueOnError)
+	flags.SetOutput(out)
+	from := flags.String("from", "", "profile to start from, the default bidding when empty")
+	to := flags.String("out", "bidding.json", "file to write the tuned profile to, load it with -bidding")
+	deals := flags.Int("deals", 200, "duplicate deals each trial plays")
+	rounds := flags.Int("rounds", 3, "passes over every weight and threshold")
+	seed := flags.Int64("seed", 1, "seed of the first deal, the same seed tunes the same way")
+	workers := flags.Int("workers", runtime.NumCPU(), "games played at once")
+	if err := flags.Parse(args); errors.Is(err, flag.ErrHelp) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	start := DefaultBidding()
+	if *from != "" {
+		var err error
+		if start, err = LoadBiddingConfig(*from); err != nil {
+			return err
+		}
+	}
+	tuned, err := Tuner{
+		Start:   start,
+		Deals:   *deals,
+		Seed:    *seed,
+		Rounds:  *rounds,
+		Workers: *workers,
+		Rules:   DefaultRules(),
+		Log:     out,
+	}.Run()
+	if err != nil {
+		return err
+	}
+	if err := SaveBiddingConfig(*to, tuned); err != nil {
+		return err
+	}
+	fmt.Fprintf(out, "Wrote %s\n", *to)
+	return nil
+}
@@ -0,0 +1,75 @@
+package euchre
+
+import (
+	"bytes"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWeightedScoreUsesTheWeights(t *testing.T) {
+	// Right and left bowers, an off ace and no clubs or diamonds, the left bower being a heart
+	hand := handOf(NewCard(11, Hearts), NewCard(11, Diamonds), NewCard(1, Spades), NewCard(9, Spades), NewCard(10, Spades))
+	assert.Equal(t, hand.GetWScore(Hearts), hand.WeightedScore(Hearts, DefaultWeights()))
+	assert.Equal(t, 3+3+1+2, hand.GetWScore(Hearts))
+
+	weights := HandWeights{Right: 5, LeftWithTrump: 4, Left: 1, Trump: 2, OffAce: 2, Void: 0}
+	assert.Equal(t, 5+4+2+0, hand.WeightedScore(Hearts, weights))
+	lone := handOf(NewCard(11, Diamonds), NewCard(1, Spades))
+	assert.Equal(t, 1+2, lone.WeightedScore(Hearts, weights), "Expected the lone left bower to be worth less")
+}
+
+func TestBiddingProfileRoundTrip(t *testing.T) {
+	config := DefaultBidding()
+	config.Weights.OffAce = 2
+	config.Order[DealerSeat] = 6
+	path := filepath.Join(t.TempDir(), "profile.json")
+	assert.NoError(t, SaveBiddingConfig(path, config))
+	loaded, err := LoadBiddingConfig(path)
+	assert.NoError(t, err)
+	assert.Equal(t, config, loaded)
+
+	partial, err := ReadBiddingConfig(strings.NewReader(`{"weights": {"void": 0}}`))
+	assert.NoError(t, err)
+	assert.Equal(t, 0, partial.Weights.Void)
+	assert.Equal(t, DefaultWeights().Right, partial.Weights.Right, "Expected the weights left out to keep their defaults")
+}
+
+func TestBiddingUsesTheProfileWeights(t *testing.T) {
+	// Two small hearts and two off aces, only worth ordering up if aces count for a lot
+	hand := handOf(NewCard(9, Hearts), NewCard(10, Hearts), NewCard(1, Spades), NewCard(1, Clubs), NewCard(9, Diamonds))
+	config := DefaultBidding()
+	assert.Equal(t, Pass, config.OrderUp(hand, SecondSeat, NewCard(13, Hearts)))
+	config.Weights.OffAce = 4
+	assert.Equal(t, OrderUp, config.OrderUp(hand, SecondSeat, NewCard(13, Hearts)))
+}
+
+func TestTunerImprovesAPoorProfile(t *testing.T) {
+	// A profile that never orders up or names trump leaves every call to the other side
+	start := DefaultBidding()
+	start.Order = [4]int{12, 12, 12, 12}
+	start.Name = [4]int{12, 12, 12, 12}
+	tuner := Tuner{Start: start, Deals: 4, Seed: 4, Rounds: 1, Workers: 2, Rules: DefaultRules()}
+	tuned, err := tuner.Run()
+	assert.NoError(t, err)
+	assert.NotEqual(t, start, tuned)
+
+	margin, err := tuner.margin(tuned, start)
+	assert.NoError(t, err)
+	assert.Positive(t, margin)
+
+	again, err := tuner.Run()
+	assert.NoError(t, err)
+	assert.Equal(t, tuned, again, "Expected the same seed to tune the same way")
+}
+
+func TestTuneCommandWritesAProfile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "profile.json")
+	var out bytes.Buffer
+	assert.NoError(t, TuneCommand([]string{"-deals", "2", "-rounds", "1", "-out", path}, &out))
+	assert.Contains(t, out.String(), "Wrote "+path)
+	_, err := LoadBiddingConfig(path)
+	assert.NoError(t, err)
+}
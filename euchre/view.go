@@ -0,0 +1,138 @@
+package euchre
+
+// A PlayerView is the table as one seat sees it: its own hand, the up card while bidding, who called what,
+// every card played so far and the scores. It holds none of the other hands or the kitty, so the computer
+// players decide from it and remote clients are sent it, rather than the Round.
+type PlayerView struct {
+	Seat              int          `json:"seat"` // -1 for someone watching
+	Phase             Phase        `json:"phase"`
+	Dealer            int          `json:"dealer"`
+	ActiveSeat        int          `json:"activeSeat"`
+	YourTurn          bool         `json:"yourTurn"`
+	Players           []string     `json:"players"`
+	Computer          []bool       `json:"computer"` // seats played by the computer
+	Scores            []int        `json:"scores"`   // by seat, partners share a score
+	Hand              []*Card      `json:"hand"`
+	HandSizes         []int        `json:"handSizes"`
+	UpCard            *Card        `json:"upCard,omitempty"`
+	FirstBiddingRound bool         `json:"firstBiddingRound"`
+	DealerStuck       bool         `json:"dealerStuck"`
+	TurnedUp          *Card        `json:"turnedUp,omitempty"` // stays known after bidding, the dealer may hold it
+	Bids              []BidRecord  `json:"bids"`
+	Trump             Suit         `json:"trump"`
+	Caller            int          `json:"caller"` // -1 until trump is called
+	Alone             bool         `json:"alone"`
+	Defender          int          `json:"defender"` // -1 unless someone defends alone
+	SittingOut        []bool       `json:"sittingOut"`
+	Lead              int          `json:"lead"`
+	Trick             [4]*Card     `json:"trick"` // by seat
+	LastTrick         [4]*Card     `json:"lastTrick"`
+	Plays             []PlayRecord `json:"plays"` // every card played this hand
+	LegalPlays        []*Card      `json:"legalPlays,omitempty"`
+	DiscardPlan       *DiscardPlan `json:"discardPlan,omitempty"` // the suggested discard while the seat is a dealer with six cards
+	Result            string       `json:"result,omitempty"`
+	Rules             Rules        `json:"rules"`
+
+	CardMap CardMap `json:"-"` // the seat's own hand and the cards it has seen, for the computer's decisions
+}
+
+// View is the current table from the seat, a seat of -1 sees only what is face up
+func (e *Engine) View(seat int) *PlayerView {
+	view := e.Round.View(seat, e.CurrentTrick())
+	view.Phase = e.Phase
+	view.ActiveSeat = e.ActiveSeat()
+	view.YourTurn = seat >= 0 && e.WaitingOnPlayer() && view.ActiveSeat == seat
+	view.Trick = e.Trick
+	view.LastTrick = e.LastTrick
+	if view.YourTurn && e.Phase == PhasePlay {
+		view.LegalPlays = e.LegalPlays(seat)
+	}
+	return view
+}
+
+// View is the round from the seat, with the cards already played to the current trick in order
+func (round *Round) View(seat int, trick []*Card) *PlayerView {
+	view := &PlayerView{
+		Seat:       seat,
+		Dealer:     round.Dealer,
+		ActiveSeat: round.ActivePlayer,
+		Trump:      round.Trump,
+		Caller:     round.seatOf(round.Caller),
+		Alone:      round.Alone,
+		Defender:   round.seatOf(round.Defender),
+		Lead:       round.Lead,
+		Plays:      append([]PlayRecord{}, round.Plays...),
+		Bids:       append([]BidRecord{}, round.Bids...),
+		Rules:      round.rules(),
+	}
+	for i, player := range round.Players {
+		score := 0
+		if player.Team != nil {
+			score = player.Team.Score
+		}
+		view.Players = append(view.Players, player.Name)
+		view.Computer = append(view.Computer, player.ComputerPlayer)
+		view.Scores = append(view.Scores, score)
+		view.HandSizes = append(view.HandSizes, len(player.CardMap.ToSlice()))
+		view.SittingOut = append(view.SittingOut, round.SittingOut(i))
+	}
+	if round.TurnedUp != nil {
+		turnedUp := *round.TurnedUp
+		view.TurnedUp = &turnedUp
+	}
+	// Once bidding is over the top of the kitty is face down, the dealer may have picked the up card up
+	if round.SelectingTrump {
+		if upCard := round.UpCard(); upCard != nil {
+			card := *upCard
+			view.UpCard = &card
+			view.FirstBiddingRound = card.FaceUp
+		}
+		view.DealerStuck = round.DealerIsStuck()
+	}
+	if seat >= 0 {
+		view.CardMap = round.Players[seat].CardMap
+		view.Hand = view.CardMap.ToSlice()
+		if seat == round.Dealer && !round.SelectingTrump && round.DealerMustDiscard() {
+			plan := PlanDiscard(view.Hand, round.Trump)
+			view.DiscardPlan = &plan
+		}
+	}
+	// The cards were played by the seats before the one to play next, whoever is looking
+	if len(trick) > 0 {
+		players := round.trickPlayers(round.Players[round.ActivePlayer], len(trick))
+		view.Lead = round.seatOf(players[0])
+		for i, player := range players {
+			view.Trick[round.seatOf(player)] = trick[i]
+		}
+	}
+	if round.Result != nil {
+		view.Result = round.Result.Describe()
+	}
+	return view
+}
+
+// CurrentTrick is the cards played to the trick so far, in the order they were played
+func (view *PlayerView) CurrentTrick() []*Card {
+	var trick []*Card
+	for _, seat := range view.trickSeats() {
+		trick = append(trick, view.Trick[seat])
+	}
+	return trick
+}
+
+// trickSeats lists who has played to the current trick, starting from the lead
+func (view *PlayerView) trickSeats() []int {
+	var seats []int
+	players := len(view.Players)
+	for i := 0; i < players; i++ {
+		if seat := (view.Lead + i) % players; view.Trick[seat] != nil {
+			seats = append(seats, seat)
+		}
+	}
+	return seats
+}
+
+// Partner is the seat across the table
+func (view *PlayerView) Partner() int {
+	return (view.Seat + 2) % len(view.Players)
+}
@@ -0,0 +1,156 @@
+package euchre
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// hiddenFrom lists the cards the seat has no right to know: the other hands and the kitty under the up card.
+// The turned up card is public, even once the dealer has picked it up.
+func hiddenFrom(e *Engine, seat int) []*Card {
+	var hidden []*Card
+	for other, player := range e.Round.Players {
+		if other != seat {
+			hidden = append(hidden, player.CardMap.ToSlice()...)
+		}
+	}
+	kitty := e.Round.Deck.Cards
+	if e.Round.SelectingTrump && len(kitty) > 0 {
+		kitty = kitty[1:]
+	}
+	hidden = append(hidden, kitty...)
+	// The dealer's discard is in no hand, the kitty or the plays, only the dealer knows it
+	if seat != e.Round.Dealer {
+		for _, card := range e.Game.Rules.NewDeck().Cards {
+			if !inPlay(e.Round, card) {
+				hidden = append(hidden, card)
+			}
+		}
+	}
+	var secret []*Card
+	for _, card := range hidden {
+		if turnedUp := e.Round.TurnedUp; turnedUp == nil || card.Rank != turnedUp.Rank || card.Suit != turnedUp.Suit {
+			secret = append(secret, card)
+		}
+	}
+	return secret
+}
+
+// inPlay reports whether the card is in a hand, the kitty or has been played this hand
+func inPlay(round *Round, card *Card) bool {
+	for _, player := range round.Players {
+		if player.CardMap.HasInHand(card) {
+			return true
+		}
+	}
+	for _, play := range round.Plays {
+		if play.Card.Rank == card.Rank && play.Card.Suit == card.Suit {
+			return true
+		}
+	}
+	return ContainsCard(round.Deck.Cards, card)
+}
+
+// visibleCards collects every card in the view as a client would receive it
+func visibleCards(t *testing.T, view *PlayerView) []*Card {
+	data, err := json.Marshal(view)
+	assert.NoError(t, err)
+	var sent PlayerView
+	assert.NoError(t, json.Unmarshal(data, &sent))
+
+	cards := append([]*Card{}, sent.Hand...)
+	cards = append(cards, sent.LegalPlays...)
+	for _, card := range append(sent.Trick[:], sent.LastTrick[:]...) {
+		if card != nil {
+			cards = append(cards, card)
+		}
+	}
+	for _, play := range sent.Plays {
+		cards = append(cards, play.Card)
+	}
+	if sent.UpCard != nil {
+		cards = append(cards, sent.UpCard)
+	}
+	if sent.TurnedUp != nil {
+		cards = append(cards, sent.TurnedUp)
+	}
+	return cards
+}
+
+func assertNoLeaks(t *testing.T, e *Engine) {
+	for seat := -1; seat < len(e.Round.Players); seat++ {
+		view := e.View(seat)
+		hidden := hiddenFrom(e, seat)
+		for _, card := range visibleCards(t, view) {
+			assert.False(t, ContainsCard(hidden, card), "Seat %d can see the %s", seat, cardName(card))
+		}
+		for _, card := range hidden {
+			assert.False(t, view.CardMap.HasInHand(card) || view.CardMap.HasSeen(card),
+				"Seat %d's card map knows the %s", seat, cardName(card))
+		}
+	}
+}
+
+func TestViewsNeverShowHiddenCards(t *testing.T) {
+	rules := DefaultRules()
+	rules.DefendAlone = true
+	for seed := int64(1); seed <= 5; seed++ {
+		engine := NewEngine(CreateSeededGame(CreateComputerPlayers(), rules, seed))
+		engine.NewGame(false)
+		for steps := 0; engine.Phase != PhaseGameOver; steps++ {
+			if steps > 5000 {
+				t.Fatal("Expected the game to finish")
+			}
+			assertNoLeaks(t, engine)
+			if !step(t, engine) {
+				assert.NoError(t, engine.NextHand())
+			}
+		}
+	}
+}
+
+func TestViewHidesTheUpCardOnceItIsPickedUp(t *testing.T) {
+	engine := NewEngine(CreateSeededGame(CreatePlayers(), DefaultRules(), 4))
+	engine.NewGame(false)
+	round := engine.Round
+	upCard := *round.UpCard()
+	assert.Equal(t, &upCard, engine.View(round.ActivePlayer).UpCard)
+	assert.True(t, engine.View(-1).FirstBiddingRound)
+
+	assert.NoError(t, engine.Bid(round.ActivePlayer, OrderUp, upCard.Suit))
+	for seat := -1; seat < 4; seat++ {
+		view := engine.View(seat)
+		assert.Nil(t, view.UpCard)
+		assert.Equal(t, upCard.Suit, view.Trump)
+	}
+	dealer := engine.View(round.Dealer)
+	assert.True(t, dealer.CardMap.HasInHand(&upCard), "Expected the dealer to see the card they picked up")
+	assert.Len(t, dealer.Hand, 6)
+}
+
+func TestWatcherViewHasNoHand(t *testing.T) {
+	engine := NewEngine(CreateSeededGame(CreatePlayers(), DefaultRules(), 8))
+	engine.NewGame(false)
+	view := engine.View(-1)
+	assert.Empty(t, view.Hand)
+	assert.False(t, view.YourTurn)
+	assert.Equal(t, []int{5, 5, 5, 5}, view.HandSizes)
+}
+
+func TestEverySeatSeesTheTrickFromTheLead(t *testing.T) {
+	engine := NewEngine(CreateSeededGame(CreateComputerPlayers(), DefaultRules(), 3))
+	engine.NewGame(false)
+	for engine.Phase != PhasePlay || len(engine.CurrentTrick()) != 2 {
+		assert.True(t, step(t, engine))
+	}
+	trick := engine.CurrentTrick()
+	for seat := -1; seat < 4; seat++ {
+		view := engine.View(seat)
+		assert.Equal(t, engine.Round.Lead, view.Lead, "Seat %d", seat)
+		assert.Equal(t, engine.Trick, view.Trick, "Seat %d", seat)
+		assert.Equal(t, trick, view.CurrentTrick(), "Seat %d", seat)
+		assert.Equal(t, engine.Trick, engine.Round.View(seat, trick).Trick, "Seat %d", seat)
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"runtime"
 	"time"
 
+	"Euchreww/euchre"
 	"fyne.io/fyne/v2"
 	"fyne.io/fyne/v2/container"
 	"fyne.io/fyne/v2/widget"
@@ -13,9 +14,10 @@ import (
 type GameUI struct {
 	Window      fyne.Window
 	MainContent fyne.CanvasObject
-	Players     []*Player
-	Round       *Round
-	Game        *Game
+	Players     []*euchre.Player
+	Round       *euchre.Round
+	Game        *euchre.Game
+	Engine      *euchre.Engine
 
 	// UI components
 	HandBox        *fyne.Container
@@ -29,7 +31,6 @@ type GameUI struct {
 	SouthScore     *widget.Label
 	WestScore      *widget.Label
 
-	Trick                [4]*Card
 	NewGameBtn           *widget.Button
 	SouthHandBox         *fyne.Container
 	BottomArea           *fyne.Container
@@ -39,10 +40,19 @@ type GameUI struct {
 	WestDealerIndicator  *widget.Label
 	discardDialog        *widget.PopUp
 	CallerIndicator      *widget.Label
+	botPending           bool
+	autosavePending      bool
 }
 
+// The human always sits South
+const humanSeat = 2
+
 func (ui *GameUI) RefreshUI() {
 	fmt.Println("Refreshing UI")
+	ui.Round = ui.Engine.Round
+	if ui.autosavePending {
+		ui.autosave()
+	}
 	// Update all static elements
 	if ui.discardDialog != nil {
 		ui.discardDialog.Hide()
@@ -53,36 +63,140 @@ func (ui *GameUI) RefreshUI() {
 	ui.KittyContainer.Objects = []fyne.CanvasObject{kitty}
 
 	// Update scores
-	ui.NorthScore.SetText(fmt.Sprintf("Score: %d", ui.Players[0].Score))
-	ui.EastScore.SetText(fmt.Sprintf("Score: %d", ui.Players[1].Score))
-	ui.SouthScore.SetText(fmt.Sprintf("Score: %d", ui.Players[2].Score))
-	ui.WestScore.SetText(fmt.Sprintf("Score: %d", ui.Players[3].Score))
+	ui.NorthScore.SetText(fmt.Sprintf("Score: %d", ui.Players[0].Team.Score))
+	ui.EastScore.SetText(fmt.Sprintf("Score: %d", ui.Players[1].Team.Score))
+	ui.SouthScore.SetText(fmt.Sprintf("Score: %d", ui.Players[2].Team.Score))
+	ui.WestScore.SetText(fmt.Sprintf("Score: %d", ui.Players[3].Team.Score))
 
 	// Update
 	ui.updateHumanHand()
 	ui.updateCallerIndicator()
 	ui.updateDealerIndicators()
-	//ui.updateTrickDisplay([4]*Card(make([]*Card, 4)))
-	
 
-	if ui.Round.SelectingTrump {
-		if ui.Round.ActivePlayer == 2 { // Human's turn to order
+	switch ui.Engine.Phase {
+	case euchre.PhaseBidding:
+		if ui.Engine.WaitingOnHuman() {
 			ui.showTrumpSelection()
 		} else {
-			// Computer decides automatically
-			ui.processComputerTrumpSelection()
+			ui.Window.SetContent(ui.MainContent)
 		}
-	} else {
-		// Card play phase
-		ui.Window.SetContent(ui.MainContent)
-		if ui.Round.ActivePlayer == 2 {
-			// Human's turn to play card
+	case euchre.PhaseDefendAlone:
+		if ui.Engine.WaitingOnHuman() {
+			ui.showDefendAloneSelection()
 		} else {
-			// Computer plays automatically
-			ui.playComputerTurn()
+			ui.Window.SetContent(ui.MainContent)
+		}
+	case euchre.PhaseDiscard:
+		ui.Window.SetContent(ui.MainContent)
+		if ui.Engine.WaitingOnHuman() {
+			ui.showDiscardSelection()
 		}
+	case euchre.PhasePlay:
+		ui.Window.SetContent(ui.MainContent)
+		ui.updateTrickDisplay(ui.displayedTrick())
+	case euchre.PhaseHandOver, euchre.PhaseGameOver:
+		ui.updateTrickDisplay(ui.Engine.LastTrick)
+		ui.showHandResult()
+	}
+
+	// Computer players take their turns in the background so the table can be seen between moves
+	if !ui.Engine.WaitingOnHuman() {
+		ui.scheduleComputerTurn()
 	}
-	
+}
+
+// displayedTrick keeps the last completed trick on the table until the next card is led
+func (ui *GameUI) displayedTrick() [4]*euchre.Card {
+	for _, card := range ui.Engine.Trick {
+		if card != nil {
+			return ui.Engine.Trick
+		}
+	}
+	return ui.Engine.LastTrick
+}
+
+func (ui *GameUI) scheduleComputerTurn() {
+	if ui.botPending || !ui.Engine.WaitingOnPlayer() {
+		return
+	}
+	ui.botPending = true
+	ui.showComputerDecision(ui.Players[ui.Engine.ActiveSeat()], "Thinking...", euchre.Suit(-1))
+	go func() {
+		time.Sleep(1 * time.Second)
+		fyne.Do(func() {
+			ui.botPending = false
+			if _, err := ui.Engine.Step(); err != nil {
+				fmt.Println(err)
+			}
+			ui.RefreshUI()
+		})
+	}()
+}
+
+// onEngineEvent shows what the computer players decided
+func (ui *GameUI) onEngineEvent(event euchre.Event) {
+	switch event.Type {
+	case euchre.EventDeal:
+		ui.clearTrickDisplay()
+	case euchre.EventBid:
+		ui.showComputerDecision(ui.Players[event.Seat], event.Call.FriendlyCall(), event.Suit)
+	case euchre.EventDefendAlone:
+		text := "Playing with partner"
+		if event.Call == euchre.Alone {
+			text = "Defending alone"
+		}
+		ui.showComputerDecision(ui.Players[event.Seat], text, event.Suit)
+	case euchre.EventTrickWon:
+		fmt.Printf("%s won the trick \n", ui.Players[event.Seat].Name)
+		ui.autosavePending = true // saved once the engine has settled
+	case euchre.EventHandScored:
+		if event.Result != nil {
+			fmt.Println(event.Result.Describe())
+		}
+	}
+}
+
+func (ui *GameUI) showHandResult() {
+	text := "Game over"
+	if result := ui.Round.Result; result != nil {
+		text = result.Describe()
+	}
+	panel := container.NewHBox(widget.NewLabel(text))
+	if ui.Engine.Phase == euchre.PhaseGameOver {
+		panel.Add(widget.NewLabel(fmt.Sprintf("%s win the game!", ui.Game.Winner().Name)))
+	} else {
+		if ui.Game.Rules.AllowReneges {
+			panel.Add(widget.NewButton("Call Renege", func() {
+				if _, err := ui.Engine.CallRenege(humanSeat); err != nil {
+					fmt.Println(err)
+				}
+				ui.RefreshUI()
+			}))
+		}
+		nextBtn := widget.NewButton("Next Hand", func() {
+			ui.Engine.NextHand()
+			ui.RefreshUI()
+		})
+		nextBtn.Importance = widget.HighImportance
+		panel.Add(nextBtn)
+	}
+	ui.showBottomPanel(panel)
+}
+
+// showBottomPanel swaps the human's hand for a prompt while keeping the rest of the table in view
+func (ui *GameUI) showBottomPanel(panel fyne.CanvasObject) {
+	content := container.NewBorder(
+		ui.MainContent.(*fyne.Container).Objects[0], // Top controls
+		container.NewVBox( // Bottom section
+			container.NewCenter(panel),
+			ui.HandBox,
+		),
+		ui.MainContent.(*fyne.Container).Objects[2], // West
+		ui.MainContent.(*fyne.Container).Objects[3], // East
+		ui.MainContent.(*fyne.Container).Objects[4], // Center
+	)
+
+	ui.Window.SetContent(content)
 }
 
 func (ui *GameUI) updateHumanHand() {
@@ -96,11 +210,16 @@ func (ui *GameUI) updateHumanHand() {
 		ui.HandBox.Objects = []fyne.CanvasObject{handContainer}
 	}
 
-	player := ui.Players[2] // South is human player
+	player := ui.Players[humanSeat]
 	cardSize := fyne.NewSize(80, 120)
 
-	// Only show play buttons if we're in the playing phase (not trump selection)
-	showPlayButtons := !ui.Round.SelectingTrump && ui.Round.Trump != Suit(-1)
+	// Only show play buttons when it's our turn to play
+	showPlayButtons := ui.Engine.Phase == euchre.PhasePlay && ui.Engine.ActiveSeat() == humanSeat
+
+	var legal []*euchre.Card
+	if showPlayButtons {
+		legal = ui.Engine.LegalPlays(humanSeat)
+	}
 
 	for _, card := range player.CardMap.ToSlice() {
 		currentCard := card
@@ -110,29 +229,15 @@ func (ui *GameUI) updateHumanHand() {
 
 		if showPlayButtons {
 			playBtn := widget.NewButton("Play", func() {
-				// Play card
-				playedCard := ui.Players[2].PlayCard(currentCard)
-				ui.Trick[2] = playedCard
-				ui.updateTrickDisplay(ui.Trick)
-
-				// If trick is complete
-				if ui.isTrickComplete() {
-					winner := resolveTrick(ui.Trick[:], ui.Round)
-					ui.Round.Lead = winner
-					ui.Round.ActivePlayer = winner
-					fmt.Printf("%s won the trick \n", ui.Players[winner].Name)
-					time.Sleep(1 * time.Second)
-					ui.clearTrickDisplay()
-					ui.Trick = [4]*Card{}
-				} else {
-					// Move to next player
-					ui.Round.ActivePlayer = (ui.Round.ActivePlayer + 1) % 4
-				}
-				// Process computer turns if needed
-				if ui.Round.ActivePlayer != 2 {
-					ui.playComputerTurn()
+				if err := ui.Engine.Play(humanSeat, currentCard); err != nil {
+					fmt.Println(err)
 				}
+				ui.RefreshUI()
 			})
+			if !euchre.ContainsCard(legal, currentCard) {
+				// Must follow suit
+				playBtn.Disable()
+			}
 			cardUI.Add(playBtn)
 		}
 		handContainer.Add(cardUI)
@@ -141,89 +246,110 @@ func (ui *GameUI) updateHumanHand() {
 }
 
 func (ui *GameUI) showTrumpSelection() {
-	if !ui.Round.SelectingTrump || ui.Round.ActivePlayer != 2 {
+	if !ui.Round.SelectingTrump || ui.Round.ActivePlayer != humanSeat {
 		return
 	}
 
-	firstRound := len(ui.Round.Deck.Cards) > 0 && ui.Round.Deck.Cards[0].FaceUp
+	firstRound := ui.Engine.FirstBiddingRound()
 
 	// Create a container for the trump selection UI
 	trumpSelectionContainer := container.NewHBox()
 
 	if firstRound {
-		topCard := ui.Round.Deck.Cards[0]
+		topCard := ui.Round.UpCard()
 		trumpSelectionContainer.Add(widget.NewLabel(fmt.Sprintf("Top card is %s of %s", topCard.FriendlyRank(), topCard.Suit.FriendlySuit())))
 		trumpSelectionContainer.Add(widget.NewLabel("Do you want to:"))
 
 		orderUpBtn := widget.NewButton("Order Up", func() {
-			ui.Round.HumanTrumpSelection(OrderUp, topCard.Suit)
-			if ui.Round.Dealer == 2 { // Human is dealer
-				ui.showDiscardSelection()
-			}
-			ui.RefreshUI()
+			ui.humanBid(euchre.OrderUp, topCard.Suit)
 		})
 		orderUpBtn.Importance = widget.HighImportance
 
 		goAloneBtn := widget.NewButton("Go Alone", func() {
-			ui.Round.HumanTrumpSelection(Alone, topCard.Suit)
-			if ui.Round.Dealer == 2 { // Human is dealer
-				ui.showDiscardSelection()
-			}
-			ui.RefreshUI()
+			ui.humanBid(euchre.Alone, topCard.Suit)
 		})
 
 		passBtn := widget.NewButton("Pass", func() {
-			ui.Round.HumanTrumpSelection(Pass, topCard.Suit)
-			ui.processComputerTrumpSelection() // Continue with next players
+			ui.humanBid(euchre.Pass, topCard.Suit)
 		})
 
 		trumpSelectionContainer.Add(orderUpBtn)
 		trumpSelectionContainer.Add(goAloneBtn)
 		trumpSelectionContainer.Add(passBtn)
+
+		if ui.Round.HasFarmersHand(humanSeat) {
+			farmerBtn := widget.NewButton("Farmer's Hand", func() {
+				hand := ui.Players[humanSeat].CardMap.ToSlice()
+				if err := ui.Engine.FarmersExchange(humanSeat, euchre.FarmersDiscards(hand)); err != nil {
+					fmt.Println(err)
+				}
+				ui.RefreshUI()
+			})
+			trumpSelectionContainer.Add(farmerBtn)
+		}
 	} else {
-		passedSuit := Suit(-1)
-		if len(ui.Round.Deck.Cards) > 0 {
-			passedSuit = ui.Round.Deck.Cards[0].Suit
+		passedSuit := euchre.Suit(-1)
+		if upCard := ui.Round.UpCard(); upCard != nil {
+			passedSuit = upCard.Suit
 		}
 
 		suitButtons := container.NewHBox()
-		for _, suit := range []Suit{Spades, Diamonds, Clubs, Hearts} {
+		for _, suit := range []euchre.Suit{euchre.Spades, euchre.Diamonds, euchre.Clubs, euchre.Hearts} {
 			if suit != passedSuit {
 				currentSuit := suit
 				btn := widget.NewButton(suit.FriendlySuit(), func() {
-					ui.Round.HumanTrumpSelection(OrderUp, currentSuit)
+					ui.humanBid(euchre.OrderUp, currentSuit)
 				})
 				btn.Importance = widget.MediumImportance
 				suitButtons.Add(btn)
 			}
 		}
 
+		if ui.Game.Rules.NoTrump {
+			suitButtons.Add(widget.NewButton(euchre.NoTrump.FriendlySuit(), func() {
+				ui.humanBid(euchre.OrderUp, euchre.NoTrump)
+			}))
+		}
+
 		trumpSelectionContainer.Add(widget.NewLabel("Choose a trump suit:"))
 		trumpSelectionContainer.Add(suitButtons)
 
-		passBtn := widget.NewButton("Pass", func() {
-			ui.Round.HumanTrumpSelection(Pass, Suit(-1))
-			ui.processComputerTrumpSelection() // Continue with next players
-		})
-		trumpSelectionContainer.Add(passBtn)
-		
+		if ui.Round.DealerIsStuck() {
+			trumpSelectionContainer.Add(widget.NewLabel("(Stuck, you must call)"))
+		} else {
+			passBtn := widget.NewButton("Pass", func() {
+				ui.humanBid(euchre.Pass, euchre.Suit(-1))
+			})
+			trumpSelectionContainer.Add(passBtn)
+		}
 	}
 
-	// Create the complete content
-	content := container.NewBorder(
-		ui.MainContent.(*fyne.Container).Objects[0], // Top controls
-		container.NewHBox( // Bottom section
-			container.NewCenter(trumpSelectionContainer),
-		),
-		ui.MainContent.(*fyne.Container).Objects[2], // West
-		ui.MainContent.(*fyne.Container).Objects[3], // East
-		ui.MainContent.(*fyne.Container).Objects[4], // Center
+	ui.showBottomPanel(trumpSelectionContainer)
+}
+
+func (ui *GameUI) showDefendAloneSelection() {
+	defend := func(alone bool) {
+		if err := ui.Engine.DefendAlone(humanSeat, alone); err != nil {
+			fmt.Println(err)
+		}
+		ui.RefreshUI()
+	}
+	panel := container.NewHBox(
+		widget.NewLabel(fmt.Sprintf("%s is going alone in %s", ui.Round.Caller.Name, ui.Round.Trump.FriendlySuit())),
+		widget.NewButton("Defend Alone", func() { defend(true) }),
+		widget.NewButton("Play With Partner", func() { defend(false) }),
 	)
+	ui.showBottomPanel(panel)
+}
 
-	ui.Window.SetContent(content)
+func (ui *GameUI) humanBid(call euchre.Call, suit euchre.Suit) {
+	if err := ui.Engine.Bid(humanSeat, call, suit); err != nil {
+		fmt.Println(err)
+	}
+	ui.RefreshUI()
 }
 
-func (ui *GameUI) updateTrickDisplay(trick [4]*Card) {
+func (ui *GameUI) updateTrickDisplay(trick [4]*euchre.Card) {
 	// Clear previous cards first
 	ui.clearTrickDisplay()
 
@@ -255,7 +381,7 @@ func (ui *GameUI) updateTrickDisplay(trick [4]*Card) {
 	ui.CenterWest.Refresh()
 }
 
-func (ui *GameUI) showComputerDecision(player *Player, text string, suit Suit) {
+func (ui *GameUI) showComputerDecision(player *euchre.Player, text string, suit euchre.Suit) {
 	ui.Window.Canvas().SetContent(ui.MainContent) // Ensure main content stays visible
 
 	var pos *fyne.Container
@@ -271,7 +397,7 @@ func (ui *GameUI) showComputerDecision(player *Player, text string, suit Suit) {
 	}
 
 	fullText := text
-	if suit != Suit(-1) {
+	if suit != euchre.Suit(-1) {
 		fullText += " " + suit.FriendlySuit()
 	}
 
@@ -283,47 +409,6 @@ func (ui *GameUI) showComputerDecision(player *Player, text string, suit Suit) {
 	pos.Refresh()
 }
 
-func (ui *GameUI) processComputerTrumpSelection() {
-	if !ui.Round.SelectingTrump {
-		return
-	}
-
-	// Only proceed if it's a computer player's turn
-	for ui.Round.SelectingTrump && ui.Round.ActivePlayer != 2 { // 2 is human position
-		player := ui.Round.Players[ui.Round.ActivePlayer]
-
-		ui.showComputerDecision(player, "Thinking...", Suit(-1))
-		time.Sleep(2 * time.Second)
-
-		var decision Call
-		var suit Suit
-
-		// Decision logic...
-		if len(ui.Round.Deck.Cards) > 0 && ui.Round.Deck.Cards[0].FaceUp {
-			suit = ui.Round.Deck.Cards[0].Suit
-			decision = player.CallOrPass(suit, ui.Round.Dealer%2 == ui.Round.ActivePlayer%2)
-		} else {
-			passedSuit := Suit(-1)
-			if len(ui.Round.Deck.Cards) > 0 {
-				passedSuit = ui.Round.Deck.Cards[0].Suit
-			}
-			decision, suit = player.DeclareTrump(passedSuit)
-		}
-
-		ui.showComputerDecision(player, decision.FriendlyCall(), suit)
-		time.Sleep(1 * time.Second)
-
-		ui.Round.ComputerTrumpSelection(decision, suit)
-		ui.RefreshUI()
-
-		// Break if it's now human's turn
-		if ui.Round.ActivePlayer == 2 {
-			ui.showTrumpSelection()
-			return
-		}
-	}
-}
-
 func (ui *GameUI) showCardPickup() {
 	if len(ui.Round.Deck.Cards) == 0 {
 		return
@@ -373,43 +458,7 @@ func (ui *GameUI) showCardPickup() {
 	ui.RefreshUI()
 }
 
-func (ui *GameUI) showDealerDiscard() {
-	if ui.Round.Dealer != 2 { // Human is not dealer
-		return
-	}
-
-	dealer := ui.Round.Players[ui.Round.Dealer]
-	if len(dealer.CardMap.ToSlice()) <= 5 {
-		return // No need to discard
-	}
-
-	// Create discard selection UI
-	discardUI := container.NewVBox(
-		widget.NewLabel("Choose a card to discard:"),
-	)
-
-	cardSize := fyne.NewSize(80, 120)
-	for _, card := range dealer.CardMap.ToSlice() {
-		currentCard := card
-		cardUI := container.NewVBox(
-			renderCardImage(currentCard, cardSize),
-			widget.NewButton("Discard", func() {
-				dealer.CardMap.RemoveFromHand(*currentCard)
-				ui.RefreshUI() // Refresh to show updated hand
-			}),
-		)
-		discardUI.Add(cardUI)
-	}
-
-	// Show modal dialog
-	dialog := widget.NewModalPopUp(
-		discardUI,
-		ui.Window.Canvas(),
-	)
-	dialog.Show()
-}
-
-func (ui *GameUI) showComputerThinking(player *Player) {
+func (ui *GameUI) showComputerThinking(player *euchre.Player) {
 	var pos *fyne.Container
 
 	switch player.Name {
@@ -431,83 +480,6 @@ func (ui *GameUI) showComputerThinking(player *Player) {
 	pos.Refresh()
 }
 
-func (ui *GameUI) playComputerTurn() {
-	computer := ui.Round.Players[ui.Round.ActivePlayer]
-	if !computer.IsPlaying {
-		ui.Round.ActivePlayer = (ui.Round.ActivePlayer + 1) % 4
-		ui.playComputerTurn() // Move to next player
-		return
-	}
-	fmt.Printf("\n\nPlayer %s is taking their turn\n", computer.Name)
-	// Show thinking indicator
-	ui.showComputerDecision(computer, "Playing...", Suit(-1))
-	time.Sleep(1 * time.Second)
-	play := computer.BestPlay(ui.getCurrentTrick(), *ui.Round)
-	playedCard := computer.PlayCard(&play)
-
-	// Update trick display
-	ui.Trick[ui.Round.ActivePlayer] = playedCard
-	ui.updateTrickDisplay(ui.Trick)
-
-	// Brief pause to see the play
-	time.Sleep(1 * time.Second)
-
-	
-
-	// If trick is complete, resolve it
-	if ui.isTrickComplete() {
-		winner := resolveTrick(ui.Trick[:], ui.Round)
-		ui.Round.Lead = winner
-		ui.Round.ActivePlayer = winner
-		fmt.Printf("%s WON the trick \n", ui.Players[winner].Name)
-		// Show completed trick for 1 second
-		time.Sleep(1 * time.Second)
-
-		// Clear the trick display
-		ui.clearTrickDisplay()
-		ui.RefreshUI()
-		// Reset trick array
-		ui.Trick = [4]*Card{}
-	}
-	// If trick is complete
-				if ui.isTrickComplete() {
-					winner := resolveTrick(ui.Trick[:], ui.Round)
-					ui.Round.Lead = winner
-					ui.Round.ActivePlayer = winner
-					fmt.Printf("%s won the trick \n", ui.Players[winner].Name)
-					time.Sleep(1 * time.Second)
-					ui.clearTrickDisplay()
-					ui.Trick = [4]*Card{}
-				} else {
-					// Move to next player
-					ui.Round.ActivePlayer = (ui.Round.ActivePlayer + 1) % 4
-				}
-	// Continue with next player if computer
-	if ui.Round.ActivePlayer != 2 {
-		ui.playComputerTurn()
-	}
-}
-
-func (ui *GameUI) getCurrentTrick() []*Card {
-	var trick []*Card
-	for _, c := range ui.Trick {
-		if c != nil {
-			trick = append(trick, c)
-		}
-	}
-	return trick
-}
-
-func (ui *GameUI) isTrickComplete() bool {
-	count := 0
-	for _, c := range ui.Trick {
-		if c != nil {
-			count++
-		}
-	}
-	return count == 4
-}
-
 func (ui *GameUI) createDealerIndicator(position int) *widget.Label {
 	indicator := widget.NewLabel("")
 	indicator.Hide() // Start hidden
@@ -573,17 +545,20 @@ func (ui *GameUI) updateDealerIndicators() {
 
 
 func (ui *GameUI) showDiscardSelection() {
-	if ui.Round.Dealer != 2 || len(ui.Players[2].CardMap.ToSlice()) <= 5 {
+	if ui.Round.Dealer != humanSeat || !ui.Round.DealerMustDiscard() {
 		return // Not human dealer or no card to discard
 	}
 
-	player := ui.Players[2]
+	player := ui.Players[humanSeat]
 	cardSize := fyne.NewSize(80, 120)
 
 	// Create the discard selection content
 	content := container.NewVBox(
 		widget.NewLabelWithStyle("Select a card to discard:", fyne.TextAlignCenter, fyne.TextStyle{Bold: true}),
 	)
+	if plan := ui.Engine.View(humanSeat).DiscardPlan; plan != nil && plan.Card != nil {
+		content.Add(widget.NewLabelWithStyle("Suggested: "+plan.Reason, fyne.TextAlignCenter, fyne.TextStyle{Italic: true}))
+	}
 
 	handContainer := container.NewHBox()
 	content.Add(handContainer)
@@ -593,7 +568,9 @@ func (ui *GameUI) showDiscardSelection() {
 		cardUI := container.NewVBox(
 			renderCardImage(currentCard, cardSize),
 			widget.NewButton("Discard", func() {
-				player.CardMap.RemoveFromHand(*currentCard)
+				if err := ui.Engine.Discard(humanSeat, currentCard); err != nil {
+					fmt.Println(err)
+				}
 				ui.discardDialog.Hide()
 				ui.RefreshUI() // Return to normal play
 			}),
@@ -601,12 +578,6 @@ func (ui *GameUI) showDiscardSelection() {
 		handContainer.Add(cardUI)
 	}
 
-	// Add cancel button
-	content.Add(widget.NewButton("Cancel", func() {
-		ui.discardDialog.Hide()
-		ui.RefreshUI()
-	}))
-
 	// Create and show the modal dialog
 	ui.discardDialog = widget.NewModalPopUp(
 		container.NewBorder(
@@ -648,7 +619,7 @@ func (ui *GameUI) clearTrickDisplay() {
 }
 
 func (ui *GameUI) updateCallerIndicator() {
-	if ui.Round.Caller == nil || ui.Round.Trump == Suit(-1) {
+	if ui.Round.Caller == nil || ui.Round.Trump == euchre.Suit(-1) {
 		ui.CallerIndicator.SetText("")
 		return
 	}
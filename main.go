@@ -1,9 +1,16 @@
 package main
 
 import (
+	"flag"
 	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"os"
 	"strings"
+	"time"
 
+	"Euchreww/euchre"
 	"fyne.io/fyne/v2"
 	"fyne.io/fyne/v2/app"
 	"fyne.io/fyne/v2/canvas"
@@ -18,37 +25,140 @@ import (
 // Human player as last play isn't removing card from their hand
 
 func main() {
-	myApp := app.New()
-	myWindow := myApp.NewWindow("Euchre")
-	myWindow.SetPadded(true)
-	handBox := container.NewHBox()
+	// The headless commands play computer games without opening a window
+	commands := map[string]func([]string, io.Writer) error{
+		"tournament": euchre.TournamentCommand,
+		"simulate":   euchre.SimulateCommand,
+		"tune":       euchre.TuneCommand,
+	}
+	if len(os.Args) > 1 && commands[os.Args[1]] != nil {
+		if err := commands[os.Args[1]](os.Args[2:], os.Stdout); err != nil {
+			fmt.Println(err)
+			os.Exit(1)
+		}
+		return
+	}
+	gameID := flag.String("game", "", "game id to replay, the deals are the same every time")
+	text := flag.Bool("text", false, "play in the terminal instead of a window")
+	serveTCP := flag.String("serve", "", "host a game for up to four players over TCP on this address, like :7070")
+	serveWS := flag.String("ws", "", "host a game over WebSocket on this address")
+	recordTo := flag.String("record", "", "file to write the game's recording to when the game is closed, .json or .jsonl")
+	bots := flag.String("bots", "", "strategy for the computer players, one name for every seat or one per seat separated by commas: "+
+		strings.Join(euchre.StrategyNames(), ", "))
+	samples := flag.Int("samples", euchre.MonteCarloSamples, "deals the montecarlo strategy tries for each card")
+	budget := flag.Duration("budget", 0, "longest the montecarlo strategy thinks about a card, like 200ms")
+	biddingFile := flag.String("bidding", "", "bidding profile for the basic computer players, a JSON file like the one tune writes")
+	newGame := flag.Bool("new", false, "start a new game instead of resuming the autosave")
+	rulesFile := flag.String("rules", "", "house rules for a new game, a JSON file like {\"deckSize\": 25, \"stickTheDealer\": true}")
+	resume := flag.Bool("resume", false, "pick up the autosaved game even though other flags set up the table, the strategy flags apply to it")
+	flag.Parse()
+	rules := euchre.DefaultRules()
+	if *rulesFile != "" {
+		var err error
+		if rules, err = euchre.LoadRules(*rulesFile); err != nil {
+			fmt.Println(err)
+			return
+		}
+	}
+	seed := time.Now().UnixNano()
+	if *gameID != "" {
+		var err error
+		if seed, err = euchre.ParseGameID(*gameID); err != nil {
+			fmt.Println(err)
+			return
+		}
+	}
+
+	// The montecarlo seats are seeded from the game too, so a game id plays the same again
+	euchre.RegisterStrategy("montecarlo", func() euchre.Strategy { return euchre.NewMonteCarlo(seed, *samples, *budget) })
+
 	// Initialize players
-	players := []*Player{
+	players := []*euchre.Player{
 		{Name: "NORTH", ComputerPlayer: true, Position: 0, IsPlaying: true},
 		{Name: "EAST", ComputerPlayer: true, Position: 1, IsPlaying: true},
 		{Name: "SOUTH", Position: 2, IsPlaying: true},
 		{Name: "WEST", ComputerPlayer: true, Position: 3, IsPlaying: true},
 	}
+	var bidding *euchre.BiddingConfig
+	if *biddingFile != "" {
+		config, err := euchre.LoadBiddingConfig(*biddingFile)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		bidding = &config
+	}
+	if err := useStrategyFlags(players, bidding, *bots); err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	if *serveTCP != "" || *serveWS != "" {
+		if err := serve(euchre.CreateSeededGame(players, rules, seed), *serveTCP, *serveWS); err != nil {
+			fmt.Println(err)
+		}
+		return
+	}
+
+	// Pick up an unfinished game after a crash or close, otherwise create a game and deal the first hand.
+	// Flags that set up the table ask for a new game, unless -resume says to apply them to the saved one.
+	var engine *euchre.Engine
+	if *gameID == "" && !*newGame && (*resume || !tableFlagsSet()) {
+		resumed, err := euchre.ResumeAutosave()
+		if err == nil {
+			err = useStrategyFlags(resumed.Game.Players, bidding, *bots)
+		}
+		if err == nil {
+			engine = resumed
+			fmt.Printf("Resumed game %s, start a new one with -new\n", engine.Game.ID)
+		} else if *resume {
+			fmt.Println("Couldn't resume the autosave:", err)
+		}
+	}
+	if engine == nil {
+		game := euchre.CreateSeededGame(players, rules, seed)
+		fmt.Printf("Game %s\n", game.ID)
+		engine = euchre.NewEngine(game)
+		engine.NewGame(false)
+	}
+	game := engine.Game
+
+	if *text {
+		term := euchre.NewTerminal(engine, humanSeat, os.Stdin, os.Stdout)
+		if path, err := euchre.AutosavePath(); err == nil {
+			term.Autosave = path
+		}
+		if err := term.Run(); err != nil {
+			fmt.Println(err)
+		}
+		if *recordTo != "" {
+			if err := engine.Recording.Save(*recordTo); err != nil {
+				fmt.Println(err)
+			}
+		}
+		return
+	}
 
+	myApp := app.New()
+	myWindow := myApp.NewWindow("Euchre")
+	myWindow.SetPadded(true)
+	handBox := container.NewHBox()
 	
 	callerIndicator := widget.NewLabel("")
 	callerIndicator.Alignment = fyne.TextAlignCenter
 	callerIndicator.TextStyle = fyne.TextStyle{Bold: true}
-	// Create game and initial round
-	game := CreateEuchreGame(players)
-	game.NewGame(false)
-	currentRound := game.Rounds[len(game.Rounds)-1]
 
-	// Initialize UI state
+	// Initialize UI state, the UI is a view over the engine
 	ui := &GameUI{
 		Window:  myWindow,
-		Players: players,
-		Round:   currentRound,
+		Players: game.Players,
+		Round:   engine.Round,
 		Game:    game,
-		Trick:   [4]*Card(make([]*Card, 4)),
+		Engine:  engine,
 		HandBox: container.NewHBox(),
 	}
 	ui.CallerIndicator = callerIndicator
+	engine.Subscribe(ui.onEngineEvent)
 
 	// Add it to your layout (modify your container as needed)
 
@@ -62,16 +172,14 @@ func main() {
 	
 
 	// Initialize score labels
-	ui.NorthScore = widget.NewLabel(fmt.Sprintf("Score: %d", players[0].Score))
-	ui.EastScore = widget.NewLabel(fmt.Sprintf("Score: %d", players[1].Score))
-	ui.SouthScore = widget.NewLabel(fmt.Sprintf("Score: %d", players[2].Score))
-	ui.WestScore = widget.NewLabel(fmt.Sprintf("Score: %d", players[3].Score))
+	ui.NorthScore = widget.NewLabel(fmt.Sprintf("Score: %d", ui.Players[0].Team.Score))
+	ui.EastScore = widget.NewLabel(fmt.Sprintf("Score: %d", ui.Players[1].Team.Score))
+	ui.SouthScore = widget.NewLabel(fmt.Sprintf("Score: %d", ui.Players[2].Team.Score))
+	ui.WestScore = widget.NewLabel(fmt.Sprintf("Score: %d", ui.Players[3].Team.Score))
 
 	// New Game button
 	newGameBtn := widget.NewButton("New Game", func() {
-		ui.Game.NewGame(false)
-		ui.Round = ui.Game.Rounds[len(ui.Game.Rounds)-1]
-		ui.Trick = [4]*Card(make([]*Card, 4))
+		ui.Engine.NewGame(false)
 
 		// Complete UI reset
 		ui.HandBox.Objects = []fyne.CanvasObject{container.NewHBox()}
@@ -160,19 +268,93 @@ func main() {
 	ui.RefreshUI()
 
 	myWindow.SetContent(ui.MainContent)
+	myWindow.SetMainMenu(ui.createMainMenu())
 	myWindow.Resize(fyne.NewSize(800, 600))
+	if *recordTo != "" {
+		myWindow.SetOnClosed(func() {
+			if err := ui.Engine.Recording.Save(*recordTo); err != nil {
+				fmt.Println(err)
+			}
+		})
+	}
 	myWindow.ShowAndRun()
 }
 
-func resolveTrick(trick []*Card, round *Round) int {
-	winningIndex := round.DetermineTrickWinner(trick, round.Lead)
-	// Update tricks won for active players only
-	round.Players[winningIndex].TricksWon++
+// tableFlagsSet reports whether any flag that sets up the players or the deals was given
+func tableFlagsSet() bool {
+	set := false
+	flag.Visit(func(f *flag.Flag) {
+		switch f.Name {
+		case "bots", "samples", "budget", "bidding", "rules":
+			set = true
+		}
+	})
+	return set
+}
 
-	return winningIndex
+// useStrategyFlags gives each seat its -bots strategy, then the basic players the -bidding profile when there is one
+func useStrategyFlags(players []*euchre.Player, bidding *euchre.BiddingConfig, bots string) error {
+	if err := useStrategies(players, bots); err != nil {
+		return err
+	}
+	if bidding != nil {
+		for _, player := range players {
+			if _, basic := player.Strategy.(euchre.Basic); basic || player.Strategy == nil {
+				player.Strategy = euchre.Basic{Bidding: bidding}
+			}
+		}
+	}
+	return nil
 }
 
-func renderCardImage(card *Card, size fyne.Size) *canvas.Image {
+// useStrategies gives each seat its strategy from the -bots flag, a blank name leaves the seat on Basic
+func useStrategies(players []*euchre.Player, names string) error {
+	if names == "" {
+		return nil
+	}
+	seats := strings.Split(names, ",")
+	for i, player := range players {
+		name := seats[0]
+		if len(seats) > 1 {
+			if i >= len(seats) {
+				break
+			}
+			name = seats[i]
+		}
+		if name = strings.TrimSpace(name); name != "" {
+			if err := player.UseStrategy(name); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// serve hosts the game until it is over
+func serve(game *euchre.Game, tcpAddress, wsAddress string) error {
+	server := euchre.NewServer(game)
+	fmt.Printf("Hosting game %s\n", game.ID)
+	errs := make(chan error, 2)
+	if tcpAddress != "" {
+		listener, err := net.Listen("tcp", tcpAddress)
+		if err != nil {
+			return err
+		}
+		defer listener.Close()
+		go func() { errs <- server.ServeTCP(listener) }()
+	}
+	if wsAddress != "" {
+		go func() { errs <- http.ListenAndServe(wsAddress, server.WebSocketHandler()) }()
+	}
+	select {
+	case <-server.Done():
+		return nil
+	case err := <-errs:
+		return err
+	}
+}
+
+func renderCardImage(card *euchre.Card, size fyne.Size) *canvas.Image {
 	suit := strings.ToLower(card.Suit.FriendlySuit())
 	rank := fmt.Sprintf("%d", card.Rank)
 	if card.Rank == 1 {
@@ -185,7 +367,12 @@ func renderCardImage(card *Card, size fyne.Size) *canvas.Image {
 		rank = "king"
 	}
 
-	img := canvas.NewImageFromFile(fmt.Sprintf("cardimages/%s_of_%s.png", rank, suit))
+	path := fmt.Sprintf("cardimages/%s_of_%s.png", rank, suit)
+	if card.IsJoker() {
+		// The 25 card deck's joker has no suit or rank of its own
+		path = "cardimages/joker.png"
+	}
+	img := canvas.NewImageFromFile(path)
 	img.FillMode = canvas.ImageFillContain
 	img.SetMinSize(size)
 	return img
@@ -198,7 +385,7 @@ func renderCardBack(size fyne.Size) *canvas.Image {
 	return img
 }
 
-func createStackedKitty(round *Round, size fyne.Size) *fyne.Container {
+func createStackedKitty(round *euchre.Round, size fyne.Size) *fyne.Container {
 	// Hide kitty when trump has been selected and play has begun
 	if !round.SelectingTrump {
 		return container.NewPadded() // Empty container when play begins
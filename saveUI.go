@@ -0,0 +1,86 @@
+package main
+
+import (
+	"fmt"
+
+	"Euchreww/euchre"
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/dialog"
+)
+
+func (ui *GameUI) createMainMenu() *fyne.MainMenu {
+	return fyne.NewMainMenu(fyne.NewMenu("Game",
+		fyne.NewMenuItem("Save Game...", ui.saveGame),
+		fyne.NewMenuItem("Load Game...", ui.loadGame),
+		fyne.NewMenuItem("Resume Autosave", ui.resumeAutosave),
+	))
+}
+
+func (ui *GameUI) saveGame() {
+	dialog.ShowFileSave(func(writer fyne.URIWriteCloser, err error) {
+		if err != nil || writer == nil {
+			return
+		}
+		defer writer.Close()
+		if err := ui.Engine.Snapshot().Write(writer); err != nil {
+			dialog.ShowError(err, ui.Window)
+		}
+	}, ui.Window)
+}
+
+func (ui *GameUI) loadGame() {
+	dialog.ShowFileOpen(func(reader fyne.URIReadCloser, err error) {
+		if err != nil || reader == nil {
+			return
+		}
+		defer reader.Close()
+		snapshot, err := euchre.ReadSnapshot(reader)
+		if err == nil {
+			err = ui.restore(snapshot)
+		}
+		if err != nil {
+			dialog.ShowError(err, ui.Window)
+		}
+	}, ui.Window)
+}
+
+func (ui *GameUI) resumeAutosave() {
+	engine, err := euchre.ResumeAutosave()
+	if err != nil {
+		dialog.ShowError(fmt.Errorf("no game to resume: %w", err), ui.Window)
+		return
+	}
+	ui.useEngine(engine)
+}
+
+func (ui *GameUI) restore(snapshot *euchre.Snapshot) error {
+	engine, err := snapshot.Restore()
+	if err != nil {
+		return err
+	}
+	ui.useEngine(engine)
+	return nil
+}
+
+// useEngine points the table at a restored game
+func (ui *GameUI) useEngine(engine *euchre.Engine) {
+	ui.Engine = engine
+	ui.Game = engine.Game
+	ui.Players = engine.Game.Players
+	ui.Round = engine.Round
+	engine.Subscribe(ui.onEngineEvent)
+	ui.clearTrickDisplay()
+	ui.RefreshUI()
+}
+
+// autosave keeps the game in progress on disk, a failed save shouldn't interrupt play
+func (ui *GameUI) autosave() {
+	ui.autosavePending = false
+	path, err := euchre.AutosavePath()
+	if err == nil {
+		err = ui.Engine.Snapshot().Save(path)
+	}
+	if err != nil {
+		fmt.Println("Autosave failed:", err)
+	}
+}